@@ -0,0 +1,91 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// SolveAngle returns the lower launch angle in degrees, negative for a shot
+// aimed below horizontal, that sends a projectile fired from origin at the
+// given power through target. Positions are in meters
+// with Y pointing up. ok is false when the target is out of range.
+func SolveAngle(target, origin Vector2, power, gravity float64) (float64, bool) {
+	dx := target.X - origin.X
+	dy := target.Y - origin.Y
+	v2 := power * power
+
+	if dx <= 0 {
+		// Straight up is the only option for a target directly above
+		if dx == 0 && dy > 0 && v2 >= 2*gravity*dy {
+			return 90, true
+		}
+		return 0, false
+	}
+
+	discriminant := v2*v2 - gravity*(gravity*dx*dx+2*dy*v2)
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	angle := math.Atan((v2-math.Sqrt(discriminant))/(gravity*dx)) * 180.0 / math.Pi
+	if angle < minAimAngle || angle > maxAimAngle {
+		return 0, false
+	}
+	return angle, true
+}
+
+// blendAim moves the manual angle towards the solved one by strength, from 0 (manual) to 1 (solution)
+func blendAim(manual, solution, strength float64) float64 {
+	strength = math.Max(0, math.Min(1, strength))
+	return manual + (solution-manual)*strength
+}
+
+// launchAngle is the angle the cannon actually fires at: the player's aim,
+// pulled towards the auto-aim solution by the assist strength
+func (g *Game) launchAngle() float64 {
+	if g.aimAssist == 0 || len(g.targets) == 0 {
+		return g.aimAngle
+	}
+	target := g.targets[g.activeTarget]
+	solution, ok := SolveAngle(g.toMeters(target.Position), g.toMeters(g.cannon), g.aimPower, g.gravity)
+	if !ok {
+		return g.aimAngle
+	}
+	return blendAim(g.aimAngle, solution, g.aimAssist)
+}
+
+// toMeters converts a screen position into meters with Y pointing up
+func (g *Game) toMeters(p Vector2) Vector2 {
+	return Vector2{p.X / g.scale, -p.Y / g.scale}
+}
+
+// autoAim points the cannon at the active target using the current power
+func (g *Game) autoAim() {
+	if len(g.targets) == 0 {
+		g.flash("No target to aim at")
+		return
+	}
+
+	target := g.targets[g.activeTarget]
+	angle, ok := SolveAngle(g.toMeters(target.Position), g.toMeters(g.cannon), g.aimPower, g.gravity)
+	if !ok {
+		g.flash("Out of range at this power")
+		return
+	}
+	g.aimAngle = angle
+}
+
+// flash shows a short message in the middle of the screen for a couple of seconds
+func (g *Game) flash(msg string) {
+	g.message = msg
+	g.messageTimer = 2.0
+}
+
+func (g *Game) drawMessage(screen *ebiten.Image) {
+	if g.messageTimer <= 0 {
+		return
+	}
+	ebitenutil.DebugPrintAt(screen, g.message, screen.Bounds().Dx()/2-len(g.message)*3, 80)
+}
@@ -0,0 +1,59 @@
+package main
+
+import "testing"
+
+func TestSolveAngle(t *testing.T) {
+	g := defaultGravity
+	tests := []struct {
+		name   string
+		target Vector2
+		power  float64
+		ok     bool
+	}{
+		{"level", Vector2{10, 0}, 12, true},
+		{"above", Vector2{8, 3}, 15, true},
+		{"below", Vector2{12, -4}, 10, true},
+		{"just inside max range", Vector2{12*12/g - 0.01, 0}, 12, true},
+		{"beyond max range", Vector2{12*12/g + 1, 0}, 12, false},
+		{"too high", Vector2{2, 20}, 12, false},
+		{"behind", Vector2{-5, 0}, 12, false},
+		{"straight up", Vector2{0, 5}, 12, true},
+		{"straight up, out of reach", Vector2{0, 20}, 12, false},
+	}
+	for _, tt := range tests {
+		angle, ok := SolveAngle(tt.target, Vector2{}, tt.power, g)
+		if ok != tt.ok {
+			t.Errorf("%s: SolveAngle ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if !ok || tt.target.X == 0 {
+			continue
+		}
+		// The solved arc must pass through the target
+		a, b := TrajectoryCoefficients(angle, tt.power, g)
+		x := tt.target.X
+		if y := a*x + b*x*x; !approxEqual(y, tt.target.Y, 1e-6) {
+			t.Errorf("%s: arc at %.2f° passes x = %v at y = %v, want %v", tt.name, angle, x, y, tt.target.Y)
+		}
+	}
+}
+
+func TestBlendAim(t *testing.T) {
+	tests := []struct {
+		manual, solution, strength float64
+		want                       float64
+	}{
+		{30, 50, 0, 30},
+		{30, 50, 1, 50},
+		{30, 50, 0.25, 35},
+		{30, 50, 0.5, 40},
+		{60, 20, 0.75, 30},
+		{30, 50, -1, 30}, // clamped to manual
+		{30, 50, 2, 50},  // clamped to the solution
+	}
+	for _, tt := range tests {
+		if got := blendAim(tt.manual, tt.solution, tt.strength); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("blendAim(%v, %v, %v) = %v, want %v", tt.manual, tt.solution, tt.strength, got, tt.want)
+		}
+	}
+}
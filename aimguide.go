@@ -0,0 +1,52 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	aimGuideDt       = 1.0 / 120.0 // seconds per integration step, finer than the preview near the cannon
+	aimGuideMaxSteps = 600         // stops a weak launch that barely moves
+)
+
+// aimGuide follows a launch through the same integration as the trajectory
+// preview, with wind, drag and spin, until the path has covered length
+// pixels, returning its points from the muzzle
+func aimGuide(params LaunchParams, length, dt float64) []Vector2 {
+	pos, vel := params.Start, params.InitialVelocity()
+	points := []Vector2{pos}
+	covered := 0.0
+	for i := 0; covered < length && i < aimGuideMaxSteps; i++ {
+		next, nextVel := params.Step(pos, vel, float64(i)*dt, dt)
+		covered += next.Add(pos.Scale(-1)).Magnitude()
+		pos, vel = next, nextVel
+		points = append(points, pos)
+	}
+	return points
+}
+
+// drawAimLine draws the aim line from the cannon, longer the more power.
+// While wind, drag, spin or the Coriolis toggle bend the flight, the straight
+// line only shows the launch direction, so it fades and a curved guide shows
+// the shot's true early path.
+func (g *Game) drawAimLine(screen *ebiten.Image) {
+	params := g.launchParams()
+	length := g.aimPower * 3
+	v := params.InitialVelocity()
+	end := g.cannon.Add(Vector2{v.X, -v.Y}.Scale(3))
+
+	lineColor := color.RGBA{255, 255, 0, 255}
+	if g.vacuumFlight() {
+		strokeLine(screen, float32(g.cannon.X), float32(g.cannon.Y), float32(end.X), float32(end.Y), 3, lineColor, g.antialias)
+		return
+	}
+
+	strokeLine(screen, float32(g.cannon.X), float32(g.cannon.Y), float32(end.X), float32(end.Y), 1, color.RGBA{255, 255, 0, 90}, g.antialias)
+	points := aimGuide(params, length, aimGuideDt)
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 3, lineColor, g.antialias)
+	}
+}
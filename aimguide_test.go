@@ -0,0 +1,40 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// lineOffset is how far p sits off the straight line from start along the
+// launch direction, in pixels, positive below the line.
+func lineOffset(params LaunchParams, p Vector2) float64 {
+	rad := params.Angle * math.Pi / 180
+	dx, dy := math.Cos(rad), -math.Sin(rad)
+	off := p.Add(params.Start.Scale(-1))
+	return dx*off.Y - dy*off.X
+}
+
+func TestAimGuideBendsWithWind(t *testing.T) {
+	const length, early = 150.0, 20
+	calm := aimGuide(vacuumParams(45, 12), length, aimGuideDt)
+	tests := []struct {
+		name string
+		wind float64
+		sign float64
+	}{
+		{"tailwind", maxWind, 1},
+		{"headwind", -maxWind, -1},
+	}
+	for _, tt := range tests {
+		params := vacuumParams(45, 12)
+		params.Wind = func(float64) float64 { return tt.wind }
+		points := aimGuide(params, length, aimGuideDt)
+		if len(points) <= early || len(calm) <= early {
+			t.Fatalf("%s: guide has %d points, calm %d, want more than %d", tt.name, len(points), len(calm), early)
+		}
+		bend := lineOffset(params, points[early]) - lineOffset(params, calm[early])
+		if bend*tt.sign < 1 {
+			t.Errorf("%s: point %d bends %.2f px off the calm guide, want at least 1 px with sign %+.0f", tt.name, early, bend, tt.sign)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	arrowHeadLength = 8.0         // pixels
+	arrowHeadSpread = math.Pi / 7 // angle of each barb off the shaft
+)
+
+// arrowhead returns the tips of the two barbs of an arrow pointing from
+// from to to. It reports false for a zero-length arrow, which has no direction.
+func arrowhead(from, to Vector2, length, spread float64) (left, right Vector2, ok bool) {
+	d := to.Add(from.Scale(-1))
+	if d.Magnitude() == 0 {
+		return Vector2{}, Vector2{}, false
+	}
+	back := math.Atan2(-d.Y, -d.X)
+	left = to.Add(Vector2{math.Cos(back + spread), math.Sin(back + spread)}.Scale(length))
+	right = to.Add(Vector2{math.Cos(back - spread), math.Sin(back - spread)}.Scale(length))
+	return left, right, true
+}
+
+// drawArrow draws a line from from to to with an arrowhead at to
+func drawArrow(screen *ebiten.Image, from, to Vector2, c color.RGBA, antialias bool) {
+	strokeLine(screen, float32(from.X), float32(from.Y), float32(to.X), float32(to.Y), 2, c, antialias)
+	left, right, ok := arrowhead(from, to, arrowHeadLength, arrowHeadSpread)
+	if !ok {
+		return
+	}
+	strokeLine(screen, float32(to.X), float32(to.Y), float32(left.X), float32(left.Y), 2, c, antialias)
+	strokeLine(screen, float32(to.X), float32(to.Y), float32(right.X), float32(right.Y), 2, c, antialias)
+}
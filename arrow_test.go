@@ -0,0 +1,35 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArrowhead(t *testing.T) {
+	const length, spread = 8.0, math.Pi / 6
+	back := length * math.Cos(spread) // how far the barbs reach back along the shaft
+	side := length * math.Sin(spread) // and out to either side of it
+	tests := []struct {
+		from, to    Vector2
+		left, right Vector2
+	}{
+		{Vector2{0, 0}, Vector2{10, 0}, Vector2{10 - back, -side}, Vector2{10 - back, side}},
+		{Vector2{10, 0}, Vector2{0, 0}, Vector2{back, side}, Vector2{back, -side}},
+		{Vector2{0, 0}, Vector2{0, 10}, Vector2{side, 10 - back}, Vector2{-side, 10 - back}},
+		{Vector2{5, 5}, Vector2{5, -20}, Vector2{5 - side, -20 + back}, Vector2{5 + side, -20 + back}},
+	}
+	for _, tt := range tests {
+		left, right, ok := arrowhead(tt.from, tt.to, length, spread)
+		if !ok {
+			t.Fatalf("arrowhead(%v, %v) found no direction", tt.from, tt.to)
+		}
+		if !approxEqual(left.X, tt.left.X, 1e-9) || !approxEqual(left.Y, tt.left.Y, 1e-9) ||
+			!approxEqual(right.X, tt.right.X, 1e-9) || !approxEqual(right.Y, tt.right.Y, 1e-9) {
+			t.Errorf("arrowhead(%v, %v) = %v, %v, want %v, %v", tt.from, tt.to, left, right, tt.left, tt.right)
+		}
+	}
+
+	if _, _, ok := arrowhead(Vector2{3, 3}, Vector2{3, 3}, length, spread); ok {
+		t.Errorf("arrowhead of a zero-length arrow reported a direction")
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const axisTickMeters = 2.0 // meters between labelled ticks
+
+// metersFromPixels converts a screen distance in pixels to meters
+func metersFromPixels(px float64, scale float64) float64 {
+	return px / scale
+}
+
+// drawAxes draws meter axes with the cannon as the origin: X level with the
+// ground below the cannon and Y up through the cannon, labelled relative to
+// the cannon's height
+func (g *Game) drawAxes(screen *ebiten.Image) {
+	axisColor := color.RGBA{255, 255, 255, 180}
+	groundY := g.terrain.SurfaceY(g.cannon.X)
+	step := axisTickMeters * g.scale
+
+	strokeLine(screen, 0, float32(groundY), screenWidth, float32(groundY), 1, axisColor, g.antialias)
+	strokeLine(screen, float32(g.cannon.X), float32(groundY), float32(g.cannon.X), 0, 1, axisColor, g.antialias)
+
+	// X ticks count out both ways from the cannon
+	first := -math.Floor(g.cannon.X / step)
+	for i := first; g.cannon.X+i*step <= screenWidth; i++ {
+		x := g.cannon.X + i*step
+		strokeLine(screen, float32(x), float32(groundY), float32(x), float32(groundY+5), 1, axisColor, g.antialias)
+		label := fmt.Sprintf("%g", metersFromPixels(x-g.cannon.X, g.scale))
+		ebitenutil.DebugPrintAt(screen, label, int(x)-3*len(label), int(groundY)+6)
+	}
+
+	// Y ticks are placed from the cannon so 0 sits at the origin even on a platform
+	for i := math.Ceil((g.cannon.Y - groundY) / step); g.cannon.Y-i*step >= 0; i++ {
+		y := g.cannon.Y - i*step
+		strokeLine(screen, float32(g.cannon.X-5), float32(y), float32(g.cannon.X), float32(y), 1, axisColor, g.antialias)
+		label := fmt.Sprintf("%g", metersFromPixels(g.cannon.Y-y, g.scale))
+		ebitenutil.DebugPrintAt(screen, label, int(g.cannon.X)-8-6*len(label), int(y)-8)
+	}
+	ebitenutil.DebugPrintAt(screen, "(0,0)", int(g.cannon.X)+6, int(g.cannon.Y)+2)
+}
@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestMetersFromPixels(t *testing.T) {
+	tests := []struct {
+		px, scale, want float64
+	}{
+		{0, defaultScale, 0},
+		{defaultScale, defaultScale, 1},
+		{250, 50, 5},
+		{-100, 50, -2}, // behind or below the cannon
+		{75, 30, 2.5},
+	}
+	for _, tt := range tests {
+		if got := metersFromPixels(tt.px, tt.scale); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("metersFromPixels(%v, %v) = %v, want %v", tt.px, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestAxesFollowPlatform(t *testing.T) {
+	groundY := float64(screenHeight - groundHeight)
+	for _, h := range []float64{0, 2, 4.5, maxPlatformHeight} {
+		g := newTestGame()
+		g.setPlatformHeight(h)
+		// The origin moves up with the cannon, leaving the ground below zero
+		if got := metersFromPixels(g.cannon.Y-groundY, g.scale); !approxEqual(got, -h, 1e-9) {
+			t.Errorf("%v m platform: ground sits at %v m on the Y axis, want %v", h, got, -h)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Camera maps world coordinates onto the screen: screen = (world - Offset) * Zoom
+type Camera struct {
+	Offset Vector2
+	Zoom   float64
+}
+
+func NewCamera() Camera {
+	return Camera{Zoom: 1}
+}
+
+// DrawOptions returns the transform used to blit the world image onto the screen
+func (c Camera) DrawOptions() *ebiten.DrawImageOptions {
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-c.Offset.X, -c.Offset.Y)
+	op.GeoM.Scale(c.Zoom, c.Zoom)
+	return op
+}
+
+// WorldToScreen converts a world position into screen pixels
+func (c Camera) WorldToScreen(p Vector2) Vector2 {
+	return Vector2{(p.X - c.Offset.X) * c.Zoom, (p.Y - c.Offset.Y) * c.Zoom}
+}
+
+// ScreenToWorld converts screen pixels (e.g. the cursor) into a world position
+func (c Camera) ScreenToWorld(p Vector2) Vector2 {
+	return Vector2{p.X/c.Zoom + c.Offset.X, p.Y/c.Zoom + c.Offset.Y}
+}
+
+// ZoomAt changes the zoom while keeping the world point under the screen position fixed
+func (c *Camera) ZoomAt(zoom float64, screenPos Vector2) {
+	anchor := c.ScreenToWorld(screenPos)
+	c.Zoom = zoom
+	c.Offset = Vector2{anchor.X - screenPos.X/zoom, anchor.Y - screenPos.Y/zoom}
+}
+
+const (
+	followSmoothing = 4.0 // 1/s, higher catches up faster
+	followSnap      = 0.5 // pixels from the goal at which the camera settles exactly
+)
+
+// dampedLerp moves current towards target by the fraction that exponential
+// smoothing at the given rate covers in dt seconds, independent of frame rate
+func dampedLerp(current, target Vector2, smoothing, dt float64) Vector2 {
+	k := 1 - math.Exp(-smoothing*dt)
+	return current.Add(target.Add(current.Scale(-1)).Scale(k))
+}
+
+// updateCameraFollow keeps a launched ball centered, as far as the view can
+// go without leaving the drawn world, and eases back to the cannon's view
+// once the ball is reset
+func (g *Game) updateCameraFollow(dt float64) {
+	goal := Vector2{}
+	if g.ball.Launched {
+		zoom := g.camera.Zoom
+		goal = Vector2{
+			X: clampFollow(g.ball.Position.X-screenWidth/2/zoom, screenWidth, zoom),
+			Y: clampFollow(g.ball.Position.Y-screenHeight/2/zoom, screenHeight, zoom),
+		}
+	}
+	g.camera.Offset = dampedLerp(g.camera.Offset, goal, followSmoothing, dt)
+	if g.camera.Offset.Add(goal.Scale(-1)).Magnitude() < followSnap {
+		g.camera.Offset = goal
+	}
+}
+
+// clampFollow limits a camera offset along one axis so a view of
+// size/zoom world pixels stays within the world's 0..size. Zoomed out, when
+// the view is larger than the world, it stays at 0.
+func clampFollow(offset, size, zoom float64) float64 {
+	return math.Max(0, math.Min(offset, size-size/zoom))
+}
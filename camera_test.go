@@ -0,0 +1,71 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDampedLerpConverges(t *testing.T) {
+	target := Vector2{800, -200}
+	tests := []struct {
+		smoothing, dt float64
+		steps         int
+	}{
+		{followSmoothing, 1.0 / 60.0, 180},
+		{followSmoothing, 1.0 / 30.0, 90},
+		{10, 1.0 / 60.0, 60},
+	}
+	for _, tt := range tests {
+		pos := Vector2{}
+		dist := target.Magnitude()
+		for i := 0; i < tt.steps; i++ {
+			pos = dampedLerp(pos, target, tt.smoothing, tt.dt)
+			d := target.Add(pos.Scale(-1)).Magnitude()
+			if d >= dist {
+				t.Fatalf("smoothing %v, dt %.3f: step %d moved no closer (%v after %v)", tt.smoothing, tt.dt, i, d, dist)
+			}
+			dist = d
+		}
+		// Exponential smoothing leaves e^(-rate·time) of the gap
+		want := target.Magnitude() * math.Exp(-tt.smoothing*tt.dt*float64(tt.steps))
+		if !approxEqual(dist, want, 1e-6) {
+			t.Errorf("smoothing %v, dt %.3f: %v px left after %d steps, want %v", tt.smoothing, tt.dt, dist, tt.steps, want)
+		}
+	}
+}
+
+func TestDampedLerpFrameRateIndependent(t *testing.T) {
+	start, target := Vector2{0, 0}, Vector2{300, 100}
+	one := dampedLerp(start, target, followSmoothing, 1.0/30.0)
+	two := dampedLerp(dampedLerp(start, target, followSmoothing, 1.0/60.0), target, followSmoothing, 1.0/60.0)
+	if !approxEqual(one.X, two.X, 1e-9) || !approxEqual(one.Y, two.Y, 1e-9) {
+		t.Errorf("one 1/30 s step = %v, two 1/60 s steps = %v, want the same", one, two)
+	}
+}
+
+func TestCameraFollowStaysInWorld(t *testing.T) {
+	tests := []struct {
+		ball Vector2
+		zoom float64
+	}{
+		{Vector2{5000, 300}, 1},
+		{Vector2{5000, -3000}, 2},
+		{Vector2{-400, 900}, 2},
+		{Vector2{1150, 20}, 4},
+		{Vector2{600, -800}, 0.5},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.camera.Zoom = tt.zoom
+		g.ball.Launched = true
+		g.ball.Position = tt.ball
+		for i := 0; i < 600; i++ {
+			g.updateCameraFollow(1.0 / 60.0)
+			off := g.camera.Offset
+			viewW, viewH := screenWidth/tt.zoom, screenHeight/tt.zoom
+			if off.X < 0 || off.Y < 0 || off.X+viewW > math.Max(screenWidth, viewW)+1e-9 || off.Y+viewH > math.Max(screenHeight, viewH)+1e-9 {
+				t.Fatalf("ball at %v, zoom %v: frame %d offset %v shows past the world edge", tt.ball, tt.zoom, i, off)
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const chargeTime = 1.5 // seconds of holding Space to go from minimum to maximum power
+
+// chargeToPower maps how long Space has been held onto a launch power,
+// ramping from minPower to maxPower over maxSeconds and staying there
+func chargeToPower(heldSeconds, maxSeconds float64) float64 {
+	f := math.Max(0, math.Min(1, heldSeconds/maxSeconds))
+	return minPower + f*(maxPower-minPower)
+}
+
+// updateCharge runs the charge mode: holding Space with the ball at the
+// cannon builds power, shown on the power gauge. It reports whether Space was
+// released, which launches.
+func (g *Game) updateCharge(dt float64) bool {
+	if g.ball.Launched || g.editing {
+		g.charging = false
+		return false
+	}
+	if g.justPressed(ActionLaunch) {
+		g.charging, g.chargeHeld = true, 0
+	}
+	if !g.charging {
+		return false
+	}
+	if g.pressed(ActionLaunch) {
+		g.chargeHeld += dt
+		g.aimPower = chargeToPower(g.chargeHeld, chargeTime)
+		return false
+	}
+	g.charging = false
+	return true
+}
+
+// drawChargeLabel marks the power gauge while charging
+func (g *Game) drawChargeLabel(screen *ebiten.Image) {
+	if g.charging {
+		ebitenutil.DebugPrintAt(screen, "CHARGING", int(g.cannon.X)-24, int(g.cannon.Y)+40)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestChargeToPower(t *testing.T) {
+	mid := (minPower + maxPower) / 2
+	tests := []struct {
+		held, max float64
+		want      float64
+	}{
+		{0, chargeTime, minPower},
+		{chargeTime / 2, chargeTime, mid},
+		{chargeTime / 4, chargeTime, minPower + (maxPower-minPower)/4},
+		{chargeTime, chargeTime, maxPower},
+		{3 * chargeTime, chargeTime, maxPower}, // held past full stays at full
+		{-1, chargeTime, minPower},
+		{1, 2, mid},
+	}
+	for _, tt := range tests {
+		if got := chargeToPower(tt.held, tt.max); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("chargeToPower(%v, %v) = %v, want %v", tt.held, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestChargeRampIncreasing(t *testing.T) {
+	prev := chargeToPower(0, chargeTime)
+	for held := 0.1; held <= chargeTime+1e-9; held += 0.1 {
+		p := chargeToPower(held, chargeTime)
+		if p <= prev {
+			t.Errorf("power %v after %.1f s held, not above %v", p, held, prev)
+		}
+		prev = p
+	}
+}
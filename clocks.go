@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// formatClock formats seconds as m:ss.s
+func formatClock(seconds float64) string {
+	seconds = math.Max(0, seconds)
+	m := int(seconds / 60)
+	return fmt.Sprintf("%d:%04.1f", m, seconds-float64(m*60))
+}
+
+// clocksText compares the real time since the session started with the
+// simulated time, which stands still while paused and runs at the time scale
+func (g *Game) clocksText(now time.Time) string {
+	return fmt.Sprintf("Real %s  Simulated %s (x%.1f)", formatClock(now.Sub(g.sessionStart).Seconds()), formatClock(g.simTime), g.timeScale)
+}
@@ -0,0 +1,68 @@
+package main
+
+import "testing"
+
+func TestSimTimeScaledAndPaused(t *testing.T) {
+	tests := []struct {
+		timeScale float64
+		paused    bool
+		want      float64 // simulated seconds after one real second
+	}{
+		{1, false, 1},
+		{0.25, false, 0.25},
+		{2, false, 2},
+		{1, true, 0},
+		{2, true, 0},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.timeScale, g.paused = tt.timeScale, tt.paused
+		for i := 0; i < 60; i++ {
+			g.advance(1.0/60.0, nil, InputIntent{})
+		}
+		if !approxEqual(g.simTime, tt.want, 1e-9) {
+			t.Errorf("scale %v, paused %v: %v s simulated in 1 s, want %v", tt.timeScale, tt.paused, g.simTime, tt.want)
+		}
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		want    string
+	}{
+		{0, "0:00.0"},
+		{5.25, "0:05.2"},
+		{59.94, "0:59.9"},
+		{61.5, "1:01.5"},
+		{754.3, "12:34.3"},
+		{-3, "0:00.0"},
+	}
+	for _, tt := range tests {
+		if got := formatClock(tt.seconds); got != tt.want {
+			t.Errorf("formatClock(%v) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestSimTimeKeepsUpWithPhysicsAfterStall(t *testing.T) {
+	tests := []struct {
+		timeScale float64
+		frames    []float64 // real seconds per frame
+	}{
+		{1, []float64{1.0 / 60.0, 2, 1.0 / 60.0}},
+		{2, []float64{0.2, 1.0 / 60.0}},
+		{0.5, []float64{3, 3}},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.timeScale = tt.timeScale
+		for _, elapsed := range tt.frames {
+			g.advance(elapsed, nil, InputIntent{})
+			if drift := g.simTime - g.time; drift < -1e-9 || drift >= physicsDt {
+				t.Errorf("scale %v, frames %v: clock at %.4f s, physics at %.4f s", tt.timeScale, tt.frames, g.simTime, g.time)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import "math"
+
+const targetHitMargin = 7.0 // pixels outside a target's drawn edge that still count as a hit
+
+// circlesOverlap reports whether circles centered at a and b with radii ra and rb intersect
+func circlesOverlap(a Vector2, ra float64, b Vector2, rb float64) bool {
+	return a.Add(b.Scale(-1)).Magnitude() < ra+rb
+}
+
+// Obstacle is an axis-aligned solid block in screen pixels
+type Obstacle struct {
+	Min, Max Vector2
+}
+
+func (o Obstacle) Contains(p Vector2) bool {
+	return p.X >= o.Min.X && p.X <= o.Max.X && p.Y >= o.Min.Y && p.Y <= o.Max.Y
+}
+
+// Expand grows the block by r on every side, so testing a ball's center
+// against it accounts for the ball's radius
+func (o Obstacle) Expand(r float64) Obstacle {
+	return Obstacle{Min: Vector2{o.Min.X - r, o.Min.Y - r}, Max: Vector2{o.Max.X + r, o.Max.Y + r}}
+}
+
+// HitKind identifies what a projectile ran into
+type HitKind int
+
+const (
+	HitNone HitKind = iota
+	HitGround
+	HitTarget
+	HitObstacle
+	HitWall
+)
+
+// Hit describes the first thing a projectile reached along its path
+type Hit struct {
+	Kind  HitKind
+	Index int // index into targets or obstacles
+	Point Vector2
+}
+
+// firstHitAlong walks the segment from -> to in small increments so fast
+// shots can't tunnel through thin obstacles, and returns the first target or
+// obstacle touched by a ball of the given radius. vel is the ball's velocity,
+// which some targets require to count a hit. Ground contact is left to the caller.
+func firstHitAlong(from, to, vel Vector2, radius float64, targets []Target, obstacles []Obstacle) Hit {
+	delta := to.Add(from.Scale(-1))
+	steps := int(math.Ceil(delta.Magnitude() / 4))
+	if steps < 1 {
+		steps = 1
+	}
+
+	for s := 1; s <= steps; s++ {
+		p := from.Add(delta.Scale(float64(s) / float64(steps)))
+		for i, target := range targets {
+			if target.acceptsHit(vel) && p.Add(target.Position.Scale(-1)).Magnitude() < target.HitRadius()+radius {
+				return Hit{Kind: HitTarget, Index: i, Point: p}
+			}
+		}
+		for i, o := range obstacles {
+			if o.Expand(radius).Contains(p) {
+				return Hit{Kind: HitObstacle, Index: i, Point: p}
+			}
+		}
+	}
+	return Hit{Kind: HitNone, Point: to}
+}
+
+// traceTrajectory integrates a launch until it touches a target, an
+// obstacle, an enabled wall or the terrain, or maxTime runs out. It returns
+// the position every dt seconds, each interval integrated in adaptive
+// substeps, and what stopped the path. A path that reaches the ground ends
+// exactly at the ground contact.
+func traceTrajectory(params LaunchParams, targets []Target, obstacles []Obstacle, walls Walls, terrain Terrain, dt, maxTime float64) ([]Vector2, Hit) {
+	radius := params.Projectile.Radius
+	pos, vel := params.Start, params.InitialVelocity()
+	points := []Vector2{pos}
+
+	for t := 0.0; t < maxTime; t += dt {
+		prev := pos
+		pos, vel = stepAdaptive(params, pos, vel, t, dt)
+
+		if hit := firstHitAlong(prev, pos, vel, radius, targets, obstacles); hit.Kind != HitNone {
+			return append(points, hit.Point), hit
+		}
+
+		if wallHit(pos, walls, radius) {
+			return append(points, pos), Hit{Kind: HitWall, Point: pos}
+		}
+
+		if terrain.Touches(pos, vel, radius) {
+			// Back up to where the path meets the ground
+			ground := terrain.Crossing(prev, pos, radius)
+			return append(points, ground), Hit{Kind: HitGround, Point: ground}
+		}
+
+		points = append(points, pos)
+	}
+	return points, Hit{Kind: HitNone, Point: pos}
+}
+
+// closestPointOnSegment returns the point of segment a-b closest to p
+func closestPointOnSegment(p, a, b Vector2) Vector2 {
+	ab := b.Add(a.Scale(-1))
+	lengthSq := ab.X*ab.X + ab.Y*ab.Y
+	if lengthSq == 0 {
+		return a
+	}
+	t := ((p.X-a.X)*ab.X + (p.Y-a.Y)*ab.Y) / lengthSq
+	t = math.Max(0, math.Min(1, t))
+	return a.Add(ab.Scale(t))
+}
@@ -0,0 +1,74 @@
+package main
+
+import "testing"
+
+func TestTraceTrajectoryStopsAtFirstHit(t *testing.T) {
+	groundY := float64(screenHeight - groundHeight)
+	params := vacuumParams(30, 20)
+	params.Start = Vector2{100, groundY - 10}
+	onPath := params.PositionAt(0.5)
+
+	tests := []struct {
+		name      string
+		targets   []Target
+		obstacles []Obstacle
+		walls     Walls
+		want      HitKind
+	}{
+		{"right wall", nil, nil, Walls{Right: true}, HitWall},
+		{"obstacle before the wall", nil, []Obstacle{{Min: Vector2{400, groundY - 300}, Max: Vector2{420, groundY}}}, Walls{Right: true}, HitObstacle},
+		{"target on the path", []Target{NewTarget(onPath.X, onPath.Y, 1)}, nil, Walls{Right: true}, HitTarget},
+		{"open field", nil, nil, Walls{}, HitGround},
+	}
+	for _, tt := range tests {
+		points, hit := traceTrajectory(params, tt.targets, tt.obstacles, tt.walls, nil, 1.0/60.0, 10)
+		if hit.Kind != tt.want {
+			t.Errorf("%s: path stopped with %v, want %v", tt.name, hit.Kind, tt.want)
+			continue
+		}
+		if last := points[len(points)-1]; last != hit.Point {
+			t.Errorf("%s: path ends at %v, not at the hit %v", tt.name, last, hit.Point)
+		}
+	}
+}
+
+func TestCirclesOverlap(t *testing.T) {
+	tests := []struct {
+		a    Vector2
+		ra   float64
+		b    Vector2
+		rb   float64
+		want bool
+	}{
+		{Vector2{0, 0}, 5, Vector2{8, 0}, 5, true},
+		{Vector2{0, 0}, 5, Vector2{10, 0}, 5, false}, // just touching
+		{Vector2{0, 0}, 5, Vector2{12, 0}, 5, false},
+		{Vector2{100, 100}, 8, Vector2{112, 116}, 20, true}, // 20 apart, 28 of radius
+		{Vector2{100, 100}, 8, Vector2{130, 140}, 20, false},
+		{Vector2{50, 50}, 2, Vector2{50, 50}, 1, true}, // concentric
+	}
+	for _, tt := range tests {
+		if got := circlesOverlap(tt.a, tt.ra, tt.b, tt.rb); got != tt.want {
+			t.Errorf("circlesOverlap(%v, %v, %v, %v) = %v, want %v", tt.a, tt.ra, tt.b, tt.rb, got, tt.want)
+		}
+		if got := circlesOverlap(tt.b, tt.rb, tt.a, tt.ra); got != tt.want {
+			t.Errorf("circlesOverlap(%v, %v, %v, %v) = %v, want %v", tt.b, tt.rb, tt.a, tt.ra, got, tt.want)
+		}
+	}
+}
+
+func TestSelfHitOnCannon(t *testing.T) {
+	g := newTestGame()
+	g.targets, g.obstacles = nil, nil
+	g.aimAngle, g.aimPower = maxAimAngle, 6
+	g.launch()
+	for i := 0; g.ball.Launched && i < 10/physicsDt; i++ {
+		g.step(physicsDt)
+	}
+	if g.ball.Launched {
+		t.Fatalf("straight up shot still in flight, want it to come down on the cannon")
+	}
+	if len(g.shotLog) != 1 || g.shotLog[0].Hit {
+		t.Errorf("self-hit logged as %+v, want one miss", g.shotLog)
+	}
+}
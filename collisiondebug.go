@@ -0,0 +1,50 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// CollisionCircle is the round collision shape of something the ball can hit, in screen pixels
+type CollisionCircle struct {
+	Center Vector2
+	Radius float64
+	Color  color.RGBA
+}
+
+// collisionCircles lists the collision shape of every round collidable: the
+// ball and any spread pellets, the targets and the cannon. A hit registers
+// exactly when the ball's circle overlaps a target's.
+func (g *Game) collisionCircles() []CollisionCircle {
+	ballColor := color.RGBA{0, 255, 255, 255}
+	circles := []CollisionCircle{
+		{g.ball.Position, g.ball.Radius, ballColor},
+		{g.cannon, cannonRadius, color.RGBA{255, 128, 0, 255}},
+	}
+	for _, p := range g.pellets {
+		circles = append(circles, CollisionCircle{p.Position, p.Radius, ballColor})
+	}
+	for _, t := range g.targets {
+		circles = append(circles, CollisionCircle{t.Position, t.HitRadius(), color.RGBA{255, 0, 255, 255}})
+	}
+	return circles
+}
+
+// drawCollisionCircle outlines one collision circle
+func drawCollisionCircle(screen *ebiten.Image, c CollisionCircle, antialias bool) {
+	strokeCircle(screen, float32(c.Center.X), float32(c.Center.Y), float32(c.Radius), 1, c.Color, antialias)
+}
+
+// drawCollisionDebug outlines every collision shape, including the obstacle
+// blocks grown by the ball's radius that its center is tested against
+func (g *Game) drawCollisionDebug(screen *ebiten.Image) {
+	for _, c := range g.collisionCircles() {
+		drawCollisionCircle(screen, c, g.antialias)
+	}
+	for _, o := range g.obstacles {
+		e := o.Expand(g.ball.Radius)
+		strokeRect(screen, float32(e.Min.X), float32(e.Min.Y), float32(e.Max.X-e.Min.X), float32(e.Max.Y-e.Min.Y),
+			1, color.RGBA{255, 255, 0, 255}, g.antialias)
+	}
+}
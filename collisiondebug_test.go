@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestCollisionCirclesEnumeratesEntities(t *testing.T) {
+	tests := []struct {
+		name             string
+		targets, pellets int
+	}{
+		{"ball and cannon only", 0, 0},
+		{"targets", 3, 0},
+		{"spread shot", 2, 4},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.pellets = nil, nil
+		g.ball.Position = Vector2{300, 350} // in flight, clear of the cannon
+		for i := 0; i < tt.targets; i++ {
+			g.targets = append(g.targets, NewTarget(500+float64(i)*100, 300, 1))
+		}
+		for i := 0; i < tt.pellets; i++ {
+			g.pellets = append(g.pellets, Ball{Position: Vector2{200, 400 - float64(i)*20}, Radius: 6})
+		}
+
+		circles := g.collisionCircles()
+		if want := 2 + tt.targets + tt.pellets; len(circles) != want {
+			t.Fatalf("%s: %d collision circles, want %d", tt.name, len(circles), want)
+		}
+		want := map[Vector2]float64{g.ball.Position: g.ball.Radius, g.cannon: cannonRadius}
+		for _, p := range g.pellets {
+			want[p.Position] = p.Radius
+		}
+		for _, target := range g.targets {
+			want[target.Position] = target.HitRadius()
+		}
+		for _, c := range circles {
+			if r, ok := want[c.Center]; !ok || r != c.Radius {
+				t.Errorf("%s: unexpected circle at %v with radius %v", tt.name, c.Center, c.Radius)
+			}
+			delete(want, c.Center)
+		}
+		if len(want) != 0 {
+			t.Errorf("%s: no circle for %v", tt.name, want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+const comboWindow = 3.0 // seconds after a target is destroyed in which the next one extends the combo
+
+// comboMultiplier returns the score multiplier for the count-th target destroyed in a row
+func comboMultiplier(count int) float64 {
+	switch {
+	case count >= 4:
+		return 3
+	case count == 3:
+		return 2
+	case count == 2:
+		return 1.5
+	}
+	return 1
+}
+
+// scoreTarget adds the points for destroying a target.
+// Scoring targets destroyed within comboWindow of each other build a combo
+// that multiplies their points, and a penalty target breaks it.
+func (g *Game) scoreTarget(t Target) {
+	points := t.Points()
+	if points < 0 {
+		g.combo = 0
+		g.addScore(points)
+		return
+	}
+
+	if g.combo > 0 && g.time-g.lastHitTime <= comboWindow {
+		g.combo++
+	} else {
+		g.combo = 1
+	}
+	g.lastHitTime = g.time
+
+	m := comboMultiplier(g.combo)
+	g.addScore(int(math.Round(float64(points) * m)))
+	if g.combo > 1 {
+		g.flash(fmt.Sprintf("Combo x%g!", m))
+	}
+}
+
+// expireCombo ends the combo once its window has lapsed
+func (g *Game) expireCombo() {
+	if g.combo > 0 && g.time-g.lastHitTime > comboWindow {
+		g.combo = 0
+	}
+}
+
+// comboText shows the running combo and how long is left to extend it
+func (g *Game) comboText() string {
+	if g.combo == 0 {
+		return "Combo: -"
+	}
+	return fmt.Sprintf("Combo: %d (x%g, %.1f s left)", g.combo, comboMultiplier(g.combo), comboWindow-(g.time-g.lastHitTime))
+}
@@ -0,0 +1,67 @@
+package main
+
+import "testing"
+
+func TestComboMultiplier(t *testing.T) {
+	tests := []struct {
+		count int
+		want  float64
+	}{
+		{0, 1},
+		{1, 1},
+		{2, 1.5},
+		{3, 2},
+		{4, 3},
+		{10, 3},
+	}
+	for _, tt := range tests {
+		if got := comboMultiplier(tt.count); got != tt.want {
+			t.Errorf("comboMultiplier(%d) = %v, want %v", tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestComboWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		hitTimes  []float64 // game seconds at which a 10 point target is destroyed
+		wantCombo int
+		wantScore int
+	}{
+		{"single hit", []float64{1}, 1, 10},
+		{"quick pair", []float64{1, 2}, 2, 10 + 15},
+		{"four in a row", []float64{1, 2, 3, 4}, 4, 10 + 15 + 20 + 30},
+		{"pair past the window", []float64{1, 1 + comboWindow + 0.1}, 1, 20},
+		{"chain broken then rebuilt", []float64{1, 2, 10, 11}, 2, 10 + 15 + 10 + 15},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		for _, at := range tt.hitTimes {
+			g.time = at
+			g.expireCombo()
+			g.scoreTarget(NewTarget(600, 300, 1))
+		}
+		if g.combo != tt.wantCombo || g.score != tt.wantScore {
+			t.Errorf("%s: combo %d, score %d, want %d, %d", tt.name, g.combo, g.score, tt.wantCombo, tt.wantScore)
+		}
+
+		// The combo lapses once its window has passed without a hit
+		g.time += comboWindow + 0.01
+		g.expireCombo()
+		if g.combo != 0 {
+			t.Errorf("%s: combo %d after the window lapsed, want 0", tt.name, g.combo)
+		}
+	}
+}
+
+func TestPenaltyBreaksCombo(t *testing.T) {
+	g := newTestGame()
+	g.scoreTarget(NewTarget(600, 300, 1))
+	g.scoreTarget(NewTarget(600, 300, 1))
+	penalty := NewTarget(600, 300, 1)
+	penalty.Kind = TargetPenalty
+	g.scoreTarget(penalty)
+	if g.combo != 0 {
+		t.Errorf("combo %d after a penalty target, want 0", g.combo)
+	}
+}
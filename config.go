@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+)
+
+const configPath = "config.json"
+
+// Config holds user settings that persist between sessions
+type Config struct {
+	HUDScale      float64           `json:"hud_scale"`
+	AirDensity    float64           `json:"air_density"` // kg/m³
+	Restitution   Surfaces          `json:"restitution"`
+	MaxFlightTime float64           `json:"max_flight_time"`          // seconds, 0 for no limit
+	CustomTargets []Vector2         `json:"custom_targets,omitempty"` // first level layout from the editor, screen pixels
+	Terrain       []float64         `json:"terrain"`                  // ground heights in meters, evenly spaced across the screen
+	CoriolisRate  float64           `json:"coriolis_rate"`            // rad/s of frame rotation while the Coriolis toggle is on
+	Keymap        map[string]string `json:"keymap,omitempty"`         // action name to key name, overriding the default keys
+}
+
+func DefaultConfig() Config {
+	return Config{HUDScale: 1, AirDensity: defaultAirDensity, Restitution: defaultSurfaces, MaxFlightTime: defaultMaxFlightTime, Terrain: defaultTerrain, CoriolisRate: defaultCoriolisRate}
+}
+
+// LoadConfig reads the config file, falling back to defaults for a missing file or missing fields
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+	err = json.Unmarshal(data, &cfg)
+	return cfg, err
+}
+
+func SaveConfig(path string, cfg Config) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,30 @@
+package main
+
+import "fmt"
+
+const defaultCoriolisRate = 0.2 // rad/s, wildly exaggerated next to Earth's 7.3e-5 so the effect shows on screen
+
+// coriolisAccel returns the Coriolis acceleration -2Ω×v in m/s² on a body
+// moving with vel (Y up) in a frame turning at omega rad/s about the axis out
+// of the screen. This is a simplified 2D model: the real Coriolis force on a
+// shot mostly deflects it sideways, out of this plane. Positive omega
+// (counterclockwise) pushes motion to the right of its direction of travel,
+// so a shot to the right is pressed down and falls short.
+func coriolisAccel(vel Vector2, omega float64) Vector2 {
+	return Vector2{vel.Y, -vel.X}.Scale(2 * omega)
+}
+
+// coriolisRate is the frame rotation applied to launches, 0 while the effect is off
+func (g *Game) coriolisRate() float64 {
+	if !g.coriolis {
+		return 0
+	}
+	return g.config.CoriolisRate
+}
+
+func (g *Game) coriolisText() string {
+	if !g.coriolis {
+		return "Coriolis: off"
+	}
+	return fmt.Sprintf("Coriolis: %.2f rad/s (simplified model)", g.config.CoriolisRate)
+}
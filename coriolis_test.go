@@ -0,0 +1,66 @@
+package main
+
+import "testing"
+
+func TestCoriolisAccel(t *testing.T) {
+	tests := []struct {
+		vel   Vector2
+		omega float64
+		want  Vector2
+	}{
+		{Vector2{10, 0}, 0.5, Vector2{0, -10}}, // moving right, pushed down
+		{Vector2{-10, 0}, 0.5, Vector2{0, 10}}, // moving left, pushed up
+		{Vector2{0, 10}, 0.5, Vector2{10, 0}},  // climbing, pushed right
+		{Vector2{10, 0}, -0.5, Vector2{0, 10}},
+		{Vector2{3, 4}, 0, Vector2{}},
+	}
+	for _, tt := range tests {
+		got := coriolisAccel(tt.vel, tt.omega)
+		if !approxEqual(got.X, tt.want.X, 1e-9) || !approxEqual(got.Y, tt.want.Y, 1e-9) {
+			t.Errorf("coriolisAccel(%v, %v) = %v, want %v", tt.vel, tt.omega, got, tt.want)
+		}
+		// It only ever turns the motion, never speeds it up or slows it down
+		if dot := got.X*tt.vel.X + got.Y*tt.vel.Y; !approxEqual(dot, 0, 1e-9) {
+			t.Errorf("coriolisAccel(%v, %v) has %v along the motion", tt.vel, tt.omega, dot)
+		}
+	}
+}
+
+func TestCoriolisShiftsLanding(t *testing.T) {
+	landingX := func(on bool, rate float64) float64 {
+		cfg := DefaultConfig()
+		cfg.CoriolisRate = rate
+		g := newGameWithConfig(defaultSeed, cfg)
+		g.targets, g.obstacles, g.terrain = nil, nil, nil
+		g.coriolis = on
+		g.aimPower = 14
+		_, hit := g.predictedPath(45)
+		if hit.Kind != HitGround {
+			t.Fatalf("rate %v: shot stopped with %v, want it to reach the ground", rate, hit.Kind)
+		}
+		return hit.Point.X
+	}
+
+	base := landingX(false, defaultCoriolisRate)
+	tests := []struct {
+		rate float64
+		sign int // of the landing shift
+	}{
+		{defaultCoriolisRate, -1}, // counterclockwise presses a rightward shot down, so it falls short
+		{0.5, -1},
+		{-defaultCoriolisRate, 1}, // clockwise lifts it, so it carries further
+		{0, 0},
+	}
+	for _, tt := range tests {
+		shift := landingX(true, tt.rate) - base
+		sign := 0
+		if shift > 1e-9 {
+			sign = 1
+		} else if shift < -1e-9 {
+			sign = -1
+		}
+		if sign != tt.sign {
+			t.Errorf("rate %v: landing moved %+.2f px, want the sign %+d", tt.rate, shift, tt.sign)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// Diagnostics is a snapshot of engine and simulation performance figures
+type Diagnostics struct {
+	FPS       float64
+	TPS       float64
+	PhysicsDt float64 // seconds of game time per physics substep
+	Balls     int
+	TrailLen  int
+	Particles int
+}
+
+func (g *Game) diagnostics() Diagnostics {
+	balls := 0
+	if g.ball.Launched && !g.ball.Landed {
+		balls = 1
+	}
+	for _, p := range g.pellets {
+		if !p.Landed {
+			balls++
+		}
+	}
+	return Diagnostics{
+		FPS:       ebiten.ActualFPS(),
+		TPS:       ebiten.ActualTPS(),
+		PhysicsDt: physicsDt,
+		Balls:     balls,
+		TrailLen:  len(g.ball.Trail),
+		Particles: g.particles.Count(),
+	}
+}
+
+func diagnosticsLines(d Diagnostics) []string {
+	return []string{
+		fmt.Sprintf("FPS: %.1f", d.FPS),
+		fmt.Sprintf("TPS: %.1f", d.TPS),
+		fmt.Sprintf("Physics dt: %.2f ms", d.PhysicsDt*1000),
+		fmt.Sprintf("Active balls: %d", d.Balls),
+		fmt.Sprintf("Trail points: %d", d.TrailLen),
+		fmt.Sprintf("Particles: %d/%d", d.Particles, maxParticles),
+	}
+}
+
+// drawDiagnostics draws the developer overlay in the bottom-right corner, apart from the main UI panel
+func (g *Game) drawDiagnostics(screen *ebiten.Image) {
+	lines := diagnosticsLines(g.diagnostics())
+	width, height := 180, len(lines)*15+10
+	x, y := screenWidth-width-10, screenHeight-height-10
+
+	fillRect(screen, float32(x), float32(y), float32(width), float32(height), color.RGBA{0, 0, 0, 160}, g.antialias)
+	for i, line := range lines {
+		ebitenutil.DebugPrintAt(screen, line, x+8, y+5+i*15)
+	}
+}
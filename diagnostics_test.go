@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDiagnosticsLines(t *testing.T) {
+	tests := []struct {
+		d    Diagnostics
+		want []string
+	}{
+		{
+			Diagnostics{FPS: 59.94, TPS: 60, PhysicsDt: 1.0 / 240.0, Balls: 3, TrailLen: 120, Particles: 45},
+			[]string{"FPS: 59.9", "TPS: 60.0", "Physics dt: 4.17 ms", "Active balls: 3", "Trail points: 120", fmt.Sprintf("Particles: 45/%d", maxParticles)},
+		},
+		{
+			Diagnostics{},
+			[]string{"FPS: 0.0", "TPS: 0.0", "Physics dt: 0.00 ms", "Active balls: 0", "Trail points: 0", fmt.Sprintf("Particles: 0/%d", maxParticles)},
+		},
+	}
+	for _, tt := range tests {
+		if got := diagnosticsLines(tt.d); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("diagnosticsLines(%+v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
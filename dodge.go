@@ -0,0 +1,20 @@
+package main
+
+import "math"
+
+// dodgeNearMisses teleports every target the ball narrowly missed
+func (g *Game) dodgeNearMisses() {
+	for i := range g.targets {
+		t := g.targets[i]
+		if isNearMiss(t.ClosestApproach, t.HitRadius()) {
+			g.targets[i].Position = g.randomTargetPosition()
+			g.targets[i].ClosestApproach = math.Inf(1)
+		}
+	}
+}
+
+// randomTargetPosition picks a spot in the current level's target area, above the terrain
+func (g *Game) randomTargetPosition() Vector2 {
+	minX, maxHeight := levelTargetArea(g.level)
+	return randomTargetSpot(g.rng, minX, maxHeight, g.terrain)
+}
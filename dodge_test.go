@@ -0,0 +1,72 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDodgeNearMisses(t *testing.T) {
+	hr := NewTarget(0, 0, 1).HitRadius()
+	tests := []struct {
+		approach float64
+		moves    bool
+	}{
+		{math.Inf(1), false}, // never came near
+		{3 * hr, false},      // wide miss
+		{1.5 * hr, true},     // near miss
+		{hr, true},           // grazed the edge
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		start := Vector2{700, 400}
+		g.targets = []Target{NewTarget(start.X, start.Y, 1)}
+		g.targets[0].ClosestApproach = tt.approach
+		g.dodgeNearMisses()
+		if moved := g.targets[0].Position != start; moved != tt.moves {
+			t.Errorf("approach %.1f hit radii: target moved = %v, want %v", tt.approach/hr, moved, tt.moves)
+		}
+	}
+}
+
+func TestDodgeModeDirectHitScores(t *testing.T) {
+	g := newTestGame()
+	g.dodgeMode = true
+	g.obstacles = nil
+	g.launch()
+	p := g.ball.Params.PositionAt(0.5)
+	g.targets = []Target{NewTarget(p.X, p.Y, 1), NewTarget(1100, 300, 1)}
+	for i := 0; i < 2/physicsDt && !g.ball.Landed; i++ {
+		g.step(physicsDt)
+	}
+	if g.score == 0 || len(g.targets) != 1 {
+		t.Errorf("direct hit in dodge mode: score %d, %d targets left, want it scored and removed", g.score, len(g.targets))
+	}
+}
+
+func TestRandomTargetPositionAboveTerrain(t *testing.T) {
+	tests := []struct {
+		level   int
+		terrain []float64 // meters
+	}{
+		{1, nil},
+		{1, []float64{0, 2, 6, 3, 8}},
+		{4, []float64{5, 5, 5}},
+		{9, defaultTerrain},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.level = tt.level
+		g.terrain = terrainFromMeters(tt.terrain, g.scale)
+		minX, maxHeight := levelTargetArea(tt.level)
+		for i := 0; i < 200; i++ {
+			p := g.randomTargetPosition()
+			surface := g.terrain.SurfaceY(p.X)
+			if p.X < minX || p.X > levelMaxX {
+				t.Fatalf("level %d: x = %.1f, outside %.0f..%.0f", tt.level, p.X, minX, levelMaxX)
+			}
+			if lift := surface - p.Y; lift < targetLift || lift > targetLift+maxHeight {
+				t.Fatalf("level %d, terrain %v: target %.1f px above the ground at x = %.1f, want %.0f..%.0f", tt.level, tt.terrain, lift, p.X, targetLift, targetLift+maxHeight)
+			}
+		}
+	}
+}
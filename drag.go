@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+const defaultAirDensity = 1.225 // kg/m³, sea level
+
+// dragAccel returns the aerodynamic drag acceleration in m/s² on a body of the
+// given mass moving with vel, from F = ½·ρ·Cd·A·v² acting against the motion
+func dragAccel(vel Vector2, mass, rho, Cd, area float64) Vector2 {
+	speed := vel.Magnitude()
+	if speed == 0 || mass == 0 {
+		return Vector2{}
+	}
+	force := 0.5 * rho * Cd * area * speed * speed
+	return vel.Scale(-force / (mass * speed))
+}
+
+// terminalVelocity returns the falling speed at which drag balances gravity,
+// +Inf when there is no drag
+func terminalVelocity(mass, gravity, rho, Cd, area float64) float64 {
+	k := 0.5 * rho * Cd * area
+	if k == 0 {
+		return math.Inf(1)
+	}
+	return math.Sqrt(mass * gravity / k)
+}
+
+func terminalVelocityText(p Projectile, gravity, rho, scale float64) string {
+	vt := terminalVelocity(p.Mass, gravity, rho, p.DragCoeff, p.Area(scale))
+	if math.IsInf(vt, 1) {
+		return "Terminal velocity: none (no drag)"
+	}
+	return fmt.Sprintf("Terminal velocity: %.1f m/s", vt)
+}
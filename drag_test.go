@@ -0,0 +1,54 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDragAccel(t *testing.T) {
+	const mass, rho, cd, area = 0.5, 1.2, 0.47, 0.01
+	tests := []struct {
+		vel, want Vector2
+	}{
+		{Vector2{10, 0}, Vector2{-0.564, 0}}, // ½·1.2·0.47·0.01·100 = 0.282 N on 0.5 kg
+		{Vector2{0, -10}, Vector2{0, 0.564}}, // falling, drag pushes up
+		{Vector2{3, 4}, Vector2{-0.0846, -0.1128}},
+		{Vector2{-20, 0}, Vector2{2.256, 0}}, // twice as fast, four times the drag
+		{Vector2{}, Vector2{}},
+	}
+	for _, tt := range tests {
+		got := dragAccel(tt.vel, mass, rho, cd, area)
+		if !approxEqual(got.X, tt.want.X, 1e-9) || !approxEqual(got.Y, tt.want.Y, 1e-9) {
+			t.Errorf("dragAccel(%v) = %v, want %v", tt.vel, got, tt.want)
+		}
+	}
+
+	if got := dragAccel(Vector2{10, 0}, mass, 0, cd, area); got != (Vector2{}) {
+		t.Errorf("dragAccel in a vacuum = %v, want none", got)
+	}
+	if got := dragAccel(Vector2{10, 0}, 0, rho, cd, area); got != (Vector2{}) {
+		t.Errorf("dragAccel on a massless body = %v, want none", got)
+	}
+}
+
+func TestTerminalVelocityBalancesGravity(t *testing.T) {
+	const rho = 1.2
+	for _, p := range projectiles {
+		area := p.Area(defaultScale)
+		vt := terminalVelocity(p.Mass, defaultGravity, rho, p.DragCoeff, area)
+		if p.DragCoeff == 0 {
+			if !math.IsInf(vt, 1) {
+				t.Errorf("%s: terminalVelocity without drag = %v, want +Inf", p.Name, vt)
+			}
+			continue
+		}
+		// Falling at terminal velocity, drag cancels gravity exactly
+		a := dragAccel(Vector2{0, -vt}, p.Mass, rho, p.DragCoeff, area)
+		if !approxEqual(a.Y, defaultGravity, 1e-9) || a.X != 0 {
+			t.Errorf("%s: drag at terminal velocity %v m/s = %v, want (0, %v)", p.Name, vt, a, defaultGravity)
+		}
+	}
+	if got := terminalVelocity(1, defaultGravity, 0, 0.47, 0.01); !math.IsInf(got, 1) {
+		t.Errorf("terminalVelocity in a vacuum = %v, want +Inf", got)
+	}
+}
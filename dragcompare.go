@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// dragComparison predicts the same launch twice through the shared
+// trajectory code: in a vacuum, and with drag as the only air effect. Wind,
+// spin and Coriolis are left out of both so the difference is drag alone.
+// Targets and obstacles are ignored so both paths fly until they reach the
+// terrain, which they end on.
+func dragComparison(params LaunchParams, terrain Terrain) (vacuum, drag []Vector2) {
+	params.Wind, params.Spin, params.Coriolis = nil, 0, 0
+	drag, _ = traceTrajectory(params, nil, nil, Walls{}, terrain, 1.0/60.0, 10.0)
+	params.AirDensity = 0
+	vacuum, _ = traceTrajectory(params, nil, nil, Walls{}, terrain, 1.0/60.0, 10.0)
+	return vacuum, drag
+}
+
+// pathRange returns the horizontal distance in meters from start to the end of a path
+func pathRange(path []Vector2, start Vector2, scale float64) float64 {
+	if len(path) == 0 {
+		return 0
+	}
+	return metersFromPixels(path[len(path)-1].X-start.X, scale)
+}
+
+// drawDragComparison overlays the vacuum and drag paths of the current aim
+// and labels how much range drag costs
+func (g *Game) drawDragComparison(screen *ebiten.Image) {
+	params := g.launchParams()
+	vacuum, drag := dragComparison(params, g.terrain)
+	vacuumColor := color.RGBA{120, 200, 255, 220}
+	dragColor := color.RGBA{255, 140, 40, 220}
+	for _, path := range []struct {
+		points []Vector2
+		c      color.RGBA
+	}{{vacuum, vacuumColor}, {drag, dragColor}} {
+		for i := 1; i < len(path.points); i++ {
+			a, b := path.points[i-1], path.points[i]
+			strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 2, path.c, g.antialias)
+		}
+	}
+
+	vacuumRange := pathRange(vacuum, params.Start, g.scale)
+	dragRange := pathRange(drag, params.Start, g.scale)
+	end := drag[len(drag)-1]
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Vacuum %.1f m, drag %.1f m", vacuumRange, dragRange), int(end.X)-60, int(end.Y)-40)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Drag costs %.1f m", vacuumRange-dragRange), int(end.X)-60, int(end.Y)-26)
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestDragShortensRange(t *testing.T) {
+	tests := []struct {
+		projectile   Projectile
+		angle, power float64
+	}{
+		{projectiles[1], 45, 20},
+		{projectiles[2], 45, 12},
+		{projectiles[2], 30, 20},
+		{projectiles[3], 20, 25},
+	}
+	for _, tt := range tests {
+		params := vacuumParams(tt.angle, tt.power)
+		params.Projectile = tt.projectile
+		params.AirDensity = defaultAirDensity
+		vacuum, drag := dragComparison(params, nil)
+		vacuumRange := pathRange(vacuum, params.Start, params.Scale)
+		dragRange := pathRange(drag, params.Start, params.Scale)
+		if dragRange <= 0 || dragRange >= vacuumRange {
+			t.Errorf("%s at %v°, %v m/s: drag range %.2f m, want shorter than vacuum range %.2f m", tt.projectile.Name, tt.angle, tt.power, dragRange, vacuumRange)
+		}
+	}
+
+	// Without drag the two paths are the same
+	params := vacuumParams(45, 12)
+	params.AirDensity = defaultAirDensity
+	vacuum, drag := dragComparison(params, nil)
+	if got, want := pathRange(drag, params.Start, params.Scale), pathRange(vacuum, params.Start, params.Scale); !approxEqual(got, want, 1e-9) {
+		t.Errorf("%s: drag range %v m, want the vacuum range %v m", params.Projectile.Name, got, want)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// The vector package functions the drawing wrappers below forward to. Tests
+// swap them out to see what reaches them.
+var (
+	vectorFillCircle   = vector.DrawFilledCircle
+	vectorFillRect     = vector.DrawFilledRect
+	vectorStrokeCircle = vector.StrokeCircle
+	vectorStrokeLine   = vector.StrokeLine
+	vectorStrokeRect   = vector.StrokeRect
+)
+
+// All vector drawing goes through these, passing on whether to anti-alias
+
+func fillCircle(dst *ebiten.Image, cx, cy, r float32, c color.Color, antialias bool) {
+	vectorFillCircle(dst, cx, cy, r, c, antialias)
+}
+
+func fillRect(dst *ebiten.Image, x, y, w, h float32, c color.Color, antialias bool) {
+	vectorFillRect(dst, x, y, w, h, c, antialias)
+}
+
+func strokeCircle(dst *ebiten.Image, cx, cy, r, width float32, c color.Color, antialias bool) {
+	vectorStrokeCircle(dst, cx, cy, r, width, c, antialias)
+}
+
+func strokeLine(dst *ebiten.Image, x0, y0, x1, y1, width float32, c color.Color, antialias bool) {
+	vectorStrokeLine(dst, x0, y0, x1, y1, width, c, antialias)
+}
+
+func strokeRect(dst *ebiten.Image, x, y, w, h, width float32, c color.Color, antialias bool) {
+	vectorStrokeRect(dst, x, y, w, h, width, c, antialias)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestDrawWrappersForwardAntialias(t *testing.T) {
+	var got []bool
+	record := func(antialias bool) { got = append(got, antialias) }
+	fc, fr, sc, sl, sr := vectorFillCircle, vectorFillRect, vectorStrokeCircle, vectorStrokeLine, vectorStrokeRect
+	defer func() {
+		vectorFillCircle, vectorFillRect, vectorStrokeCircle, vectorStrokeLine, vectorStrokeRect = fc, fr, sc, sl, sr
+	}()
+	vectorFillCircle = func(_ *ebiten.Image, _, _, _ float32, _ color.Color, aa bool) { record(aa) }
+	vectorFillRect = func(_ *ebiten.Image, _, _, _, _ float32, _ color.Color, aa bool) { record(aa) }
+	vectorStrokeCircle = func(_ *ebiten.Image, _, _, _, _ float32, _ color.Color, aa bool) { record(aa) }
+	vectorStrokeLine = func(_ *ebiten.Image, _, _, _, _, _ float32, _ color.Color, aa bool) { record(aa) }
+	vectorStrokeRect = func(_ *ebiten.Image, _, _, _, _, _ float32, _ color.Color, aa bool) { record(aa) }
+
+	tests := []struct {
+		name string
+		draw func(antialias bool)
+	}{
+		{"fillCircle", func(aa bool) { fillCircle(nil, 1, 2, 3, color.White, aa) }},
+		{"fillRect", func(aa bool) { fillRect(nil, 1, 2, 3, 4, color.White, aa) }},
+		{"strokeCircle", func(aa bool) { strokeCircle(nil, 1, 2, 3, 1, color.White, aa) }},
+		{"strokeLine", func(aa bool) { strokeLine(nil, 1, 2, 3, 4, 1, color.White, aa) }},
+		{"strokeRect", func(aa bool) { strokeRect(nil, 1, 2, 3, 4, 1, color.White, aa) }},
+		{"drawArrow", func(aa bool) { drawArrow(nil, Vector2{0, 0}, Vector2{30, 40}, color.RGBA{}, aa) }},
+	}
+	for _, tt := range tests {
+		for _, aa := range []bool{true, false} {
+			got = nil
+			tt.draw(aa)
+			if len(got) == 0 {
+				t.Errorf("%s(antialias %v) drew nothing", tt.name, aa)
+			}
+			for i, g := range got {
+				if g != aa {
+					t.Errorf("%s(antialias %v): call %d got antialias %v", tt.name, aa, i, g)
+				}
+			}
+		}
+	}
+}
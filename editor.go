@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// removeNearestTarget returns targets without the one closest to click
+func removeNearestTarget(targets []Target, click Vector2) []Target {
+	nearest, best := -1, 0.0
+	for i, t := range targets {
+		if d := t.Position.Add(click.Scale(-1)).Magnitude(); nearest < 0 || d < best {
+			nearest, best = i, d
+		}
+	}
+	if nearest < 0 {
+		return targets
+	}
+	return append(targets[:nearest:nearest], targets[nearest+1:]...)
+}
+
+// customTargets builds standard single-hit targets at the given positions
+func customTargets(positions []Vector2) []Target {
+	targets := make([]Target, 0, len(positions))
+	for _, p := range positions {
+		targets = append(targets, NewTarget(p.X, p.Y, 1))
+	}
+	return targets
+}
+
+// toggleEditor enters the target editor, or leaves it and saves any changed
+// layout to the config so it is used again for the first level. Leaving an
+// empty layout goes back to random layouts, starting with this level.
+func (g *Game) toggleEditor() {
+	if !g.editing {
+		if !g.ball.Launched {
+			g.editing = true
+			g.layoutEdited = false
+		}
+		return
+	}
+
+	g.editing = false
+	if !g.layoutEdited {
+		return
+	}
+	positions := make([]Vector2, 0, len(g.targets))
+	for _, t := range g.targets {
+		positions = append(positions, t.Position)
+	}
+	if len(positions) == 0 {
+		g.targets = GenerateTargets(g.level, g.rng)
+		g.activeTarget = 0
+	}
+	g.config.CustomTargets = positions
+	if err := SaveConfig(configPath, g.config); err != nil {
+		g.flash("Could not save layout: " + err.Error())
+		return
+	}
+	if len(positions) == 0 {
+		g.flash("Cleared the custom layout")
+		return
+	}
+	g.flash(fmt.Sprintf("Saved a layout of %d targets", len(positions)))
+}
+
+// updateEditor places a target at a left click and removes the nearest one at a right click
+func (g *Game) updateEditor() {
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !ebiten.IsKeyPressed(ebiten.KeyShift) && !ebiten.IsKeyPressed(ebiten.KeyControl) {
+		p := g.cursorWorld()
+		g.targets = append(g.targets, NewTarget(p.X, p.Y, 1))
+		g.layoutEdited = true
+	}
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) && len(g.targets) > 0 {
+		g.targets = removeNearestTarget(g.targets, g.cursorWorld())
+		g.activeTarget = clampTargetIndex(g.activeTarget, len(g.targets))
+		g.layoutEdited = true
+	}
+}
+
+// drawEditorBanner tells the player the game is in the target editor
+func (g *Game) drawEditorBanner(screen *ebiten.Image) {
+	fillRect(screen, 0, screenHeight-24, screenWidth, 24, color.RGBA{0, 0, 80, 180}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, "EDIT MODE  Click: Place Target  Right Click: Remove Nearest  E: Save & Exit",
+		10, screenHeight-20)
+}
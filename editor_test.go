@@ -0,0 +1,43 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveNearestTarget(t *testing.T) {
+	xs := func(targets []Target) []float64 {
+		var out []float64
+		for _, t := range targets {
+			out = append(out, t.Position.X)
+		}
+		return out
+	}
+	layout := func() []Target {
+		return []Target{NewTarget(400, 300, 1), NewTarget(600, 300, 1), NewTarget(800, 300, 1)}
+	}
+	tests := []struct {
+		name  string
+		click Vector2
+		want  []float64
+	}{
+		{"on a target", Vector2{600, 300}, []float64{400, 800}},
+		{"nearer the first", Vector2{490, 200}, []float64{600, 800}},
+		{"past the last", Vector2{1200, 500}, []float64{400, 600}},
+		{"far above the middle", Vector2{610, -400}, []float64{400, 800}},
+	}
+	for _, tt := range tests {
+		targets := layout()
+		got := removeNearestTarget(targets, tt.click)
+		if !reflect.DeepEqual(xs(got), tt.want) {
+			t.Errorf("%s: removeNearestTarget left targets at %v, want %v", tt.name, xs(got), tt.want)
+		}
+		if !reflect.DeepEqual(targets, layout()) {
+			t.Errorf("%s: removeNearestTarget changed the slice it was given", tt.name)
+		}
+	}
+
+	if got := removeNearestTarget(nil, Vector2{600, 300}); len(got) != 0 {
+		t.Errorf("removeNearestTarget with no targets = %v, want none", got)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const energyBarHeight = 10
+
+// energies returns the ball's kinetic and potential energy in joules, with
+// potential energy measured from the screen line refY (pixels)
+func energies(ball Ball, gravity, refY, scale float64) (ke, pe float64) {
+	return bodyEnergies(ball.Params.Projectile.Mass, ball.Position, ball.Velocity, gravity, refY, scale)
+}
+
+// bodyEnergies returns the kinetic and potential energy in joules of mass kg
+// at pos moving at vel, with potential energy measured from the screen line
+// refY (pixels)
+func bodyEnergies(mass float64, pos, vel Vector2, gravity, refY, scale float64) (ke, pe float64) {
+	speed := vel.Magnitude()
+	height := (refY - pos.Y) / scale
+	return 0.5 * mass * speed * speed, mass * gravity * height
+}
+
+// energyText labels a kinetic and potential energy pair and their total
+func energyText(ke, pe float64) string {
+	return fmt.Sprintf("KE %.0f J + PE %.0f J = %.0f J", ke, pe, ke+pe)
+}
+
+// drawEnergyBar draws kinetic (blue) and potential (green) energy stacked
+// along a bar of width w, scaled so the energy at launch, e0, fills 80% of it
+// and marked with a tick. Potential energy below the launch height is
+// negative and shown in red, eating into the kinetic part.
+func drawEnergyBar(screen *ebiten.Image, x, y, w float32, ke, pe, e0 float64, antialias bool) {
+	if e0 <= 0 {
+		return
+	}
+	px := func(e float64) float32 {
+		return float32(math.Max(0, math.Min(1, 0.8*e/e0))) * w
+	}
+
+	fillRect(screen, x, y, w, energyBarHeight, color.RGBA{0, 0, 0, 128}, antialias)
+	fillRect(screen, x, y, px(ke), energyBarHeight, color.RGBA{60, 140, 255, 255}, antialias)
+	if pe >= 0 {
+		fillRect(screen, x+px(ke), y, px(ke+pe)-px(ke), energyBarHeight, color.RGBA{60, 220, 60, 255}, antialias)
+	} else {
+		fillRect(screen, x+px(ke+pe), y, px(ke)-px(ke+pe), energyBarHeight, color.RGBA{230, 60, 60, 255}, antialias)
+	}
+	tick := x + px(e0)
+	strokeLine(screen, tick, y-2, tick, y+energyBarHeight+2, 2, color.RGBA{255, 255, 255, 255}, antialias)
+}
@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestEnergyConservedInVacuum(t *testing.T) {
+	tests := []struct {
+		angle, power float64
+	}{
+		{20, 10},
+		{45, 12},
+		{80, 18},
+	}
+	for _, tt := range tests {
+		b := Ball{MaxTrailLen: 10}
+		params := vacuumParams(tt.angle, tt.power)
+		b.Launch(params)
+		ke0, pe0 := energies(b, params.Gravity, params.Start.Y, params.Scale)
+		e0 := ke0 + pe0
+		if want := 0.5 * params.Projectile.Mass * tt.power * tt.power; pe0 != 0 || !approxEqual(ke0, want, 1e-9) {
+			t.Fatalf("%v° at %v m/s: launch energy %v J kinetic, %v J potential, want %v J and 0", tt.angle, tt.power, ke0, pe0, want)
+		}
+		for i := 1; i <= int(2/physicsDt); i++ {
+			b.Update(physicsDt)
+			ke, pe := energies(b, params.Gravity, params.Start.Y, params.Scale)
+			if !approxEqual(ke+pe, e0, 1e-6*e0) {
+				t.Fatalf("%v° at %v m/s: total energy %v J at %.2f s, want %v J", tt.angle, tt.power, ke+pe, float64(i)*physicsDt, e0)
+			}
+		}
+	}
+}
+
+func TestEnergyLostToDrag(t *testing.T) {
+	b := Ball{MaxTrailLen: 10}
+	params := vacuumParams(45, 20)
+	params.AirDensity = defaultAirDensity
+	params.Projectile.DragCoeff = 0.47
+	b.Launch(params)
+	ke, pe := energies(b, params.Gravity, params.Start.Y, params.Scale)
+	prev := ke + pe
+	for i := 0; i < int(2/physicsDt); i++ {
+		b.Update(physicsDt)
+		ke, pe = energies(b, params.Gravity, params.Start.Y, params.Scale)
+		if ke+pe >= prev {
+			t.Fatalf("total energy rose from %v J to %v J with drag on", prev, ke+pe)
+		}
+		prev = ke + pe
+	}
+}
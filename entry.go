@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+var errEmptyInput = errors.New("empty input")
+
+// parseAndClamp parses a typed number and clamps it into [min, max].
+// Blank input returns errEmptyInput so callers can keep the current value.
+func parseAndClamp(input string, min, max float64) (float64, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, errEmptyInput
+	}
+	v, err := strconv.ParseFloat(input, 64)
+	if err != nil || math.IsNaN(v) {
+		return 0, fmt.Errorf("%q is not a number", input)
+	}
+	return math.Max(min, math.Min(max, v)), nil
+}
+
+// ValueEntry is the state of the typed angle/power entry
+type ValueEntry struct {
+	Active bool
+	Stage  int // 0 while typing the angle, 1 while typing the power
+	Buffer string
+	Angle  float64
+}
+
+// openEntry starts typing a new angle, then power
+func (g *Game) openEntry() {
+	g.entry = ValueEntry{Active: true, Angle: g.aimAngle}
+}
+
+// updateEntry collects typed characters. Enter confirms the current field,
+// a blank field keeps its value and Escape cancels without changes.
+func (g *Game) updateEntry() {
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			g.entry.Buffer += string(r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.entry.Buffer) > 0 {
+		g.entry.Buffer = g.entry.Buffer[:len(g.entry.Buffer)-1]
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.entry = ValueEntry{}
+		return
+	}
+	if !inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		return
+	}
+
+	if g.entry.Stage == 0 {
+		angle, err := parseAndClamp(g.entry.Buffer, minAimAngle, maxAimAngle)
+		if err == errEmptyInput {
+			angle, err = g.aimAngle, nil
+		}
+		if err != nil {
+			g.flash(err.Error())
+			g.entry.Buffer = ""
+			return
+		}
+		g.entry.Angle = angle
+		g.entry.Stage = 1
+		g.entry.Buffer = ""
+		return
+	}
+
+	power, err := parseAndClamp(g.entry.Buffer, minPower, maxPower)
+	if err == errEmptyInput {
+		power, err = g.aimPower, nil
+	}
+	if err != nil {
+		g.flash(err.Error())
+		g.entry.Buffer = ""
+		return
+	}
+	g.aimAngle = g.entry.Angle
+	g.aimPower = power
+	g.entry = ValueEntry{}
+}
+
+func (g *Game) drawEntry(screen *ebiten.Image) {
+	prompt := fmt.Sprintf("Angle (%.0f-%.0f, now %.1f): %s_", minAimAngle, maxAimAngle, g.aimAngle, g.entry.Buffer)
+	if g.entry.Stage == 1 {
+		prompt = fmt.Sprintf("Power (%.0f-%.0f, now %.1f): %s_", minPower, maxPower, g.aimPower, g.entry.Buffer)
+	}
+
+	w := len(prompt)*6 + 20
+	x, y := (screenWidth-w)/2, screenHeight/2-60
+	fillRect(screen, float32(x), float32(y), float32(w), 50, color.RGBA{0, 0, 0, 200}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, prompt, x+10, y+8)
+	ebitenutil.DebugPrintAt(screen, "Enter: confirm  Esc: cancel  blank keeps value", x+10, y+26)
+}
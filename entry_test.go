@@ -0,0 +1,41 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAndClamp(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"45", 45, false},
+		{"  30.5 ", 30.5, false},
+		{"-45", -45, false},
+		{"120", 90, false},  // above the range
+		{"-80", -45, false}, // below the range
+		{"1e3", 90, false},
+		{"", 0, true},
+		{"   ", 0, true},
+		{"abc", 0, true},
+		{"12deg", 0, true},
+		{"NaN", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseAndClamp(tt.input, minAimAngle, maxAimAngle)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseAndClamp(%q) error = %v, want error %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("parseAndClamp(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	// Blank input is told apart so the current value can be kept
+	if _, err := parseAndClamp(" ", 0, 1); !errors.Is(err, errEmptyInput) {
+		t.Errorf("parseAndClamp of blank input = %v, want errEmptyInput", err)
+	}
+}
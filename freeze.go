@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// StateSnapshot is the ball's full instantaneous state. Position is in
+// meters from the launch point and vectors are in SI units with Y up.
+type StateSnapshot struct {
+	Time        float64
+	Position    Vector2
+	Velocity    Vector2
+	Speed       float64
+	TravelAngle float64 // degrees above horizontal of the direction of travel
+	Accel       Vector2
+	KE, PE      float64 // joules, PE measured from the launch height
+}
+
+// instantaneousState assembles a ball's snapshot from its current flight
+func instantaneousState(ball Ball, gravity float64) StateSnapshot {
+	p := ball.Params
+	ke, pe := energies(ball, gravity, p.Start.Y, p.Scale)
+	return StateSnapshot{
+		Time:        ball.Time,
+		Position:    Vector2{(ball.Position.X - p.Start.X) / p.Scale, (p.Start.Y - ball.Position.Y) / p.Scale},
+		Velocity:    ball.Velocity,
+		Speed:       ball.Velocity.Magnitude(),
+		TravelAngle: velocityAngleDeg(ball.Velocity),
+		Accel:       p.Accel(ball.Time, ball.Velocity),
+		KE:          ke,
+		PE:          pe,
+	}
+}
+
+// Lines formats the snapshot for the freeze frame readout
+func (s StateSnapshot) Lines() []string {
+	return []string{
+		fmt.Sprintf("t = %.2f s", s.Time),
+		fmt.Sprintf("x = %.2f m, y = %.2f m", s.Position.X, s.Position.Y),
+		fmt.Sprintf("Vx = %.2f m/s, Vy = %.2f m/s", s.Velocity.X, s.Velocity.Y),
+		fmt.Sprintf("Speed = %.2f m/s", s.Speed),
+		fmt.Sprintf("Travel angle = %.1f°", s.TravelAngle),
+		fmt.Sprintf("a = (%.2f, %.2f) m/s²", s.Accel.X, s.Accel.Y),
+		fmt.Sprintf("KE = %.1f J, PE = %.1f J", s.KE, s.PE),
+	}
+}
+
+// toggleFreezeFrame pauses a ball in flight with the readout up, or resumes
+func (g *Game) toggleFreezeFrame() {
+	if g.freezeFrame {
+		g.freezeFrame, g.paused = false, false
+		return
+	}
+	if g.ball.Launched && !g.ball.Landed {
+		g.freezeFrame, g.paused = true, true
+	}
+}
+
+// drawFreezeFrame draws the snapshot beside the ball, each line joined to it by a leader line
+func (g *Game) drawFreezeFrame(screen *ebiten.Image) {
+	lines := instantaneousState(g.ball, g.gravity).Lines()
+	const w = 210
+	h := len(lines)*panelLineHeight + 10
+	ball := g.ball.Position
+	x := math.Min(ball.X+60, screenWidth-w-10)
+	y := math.Max(10, ball.Y-float64(h)-40)
+
+	leader := color.RGBA{255, 255, 255, 120}
+	for i := range lines {
+		ly := y + 5 + float64(i*panelLineHeight) + 7
+		strokeLine(screen, float32(ball.X), float32(ball.Y), float32(x), float32(ly), 1, leader, g.antialias)
+	}
+	fillRect(screen, float32(x), float32(y), w, float32(h), color.RGBA{0, 0, 0, 190}, g.antialias)
+	for i, line := range lines {
+		ebitenutil.DebugPrintAt(screen, line, int(x)+6, int(y)+5+i*panelLineHeight)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestInstantaneousState(t *testing.T) {
+	params := vacuumParams(45, 12)
+	params.Gravity, params.Scale = 9.8, 50
+	m := params.Projectile.Mass
+	tests := []struct {
+		name     string
+		time     float64
+		pos, vel Vector2 // pixels, m/s
+		want     StateSnapshot
+	}{
+		{"rising", 0.5, Vector2{350, 400}, Vector2{6, 8},
+			StateSnapshot{0.5, Vector2{5, 2}, Vector2{6, 8}, 10, 53.1301, Vector2{0, -9.8}, 50 * m, 19.6 * m}},
+		{"falling", 1.2, Vector2{400, 450}, Vector2{6, -8},
+			StateSnapshot{1.2, Vector2{6, 1}, Vector2{6, -8}, 10, -53.1301, Vector2{0, -9.8}, 50 * m, 9.8 * m}},
+		{"below the launch height", 2, Vector2{600, 550}, Vector2{3, -4},
+			StateSnapshot{2, Vector2{10, -1}, Vector2{3, -4}, 5, -53.1301, Vector2{0, -9.8}, 12.5 * m, -9.8 * m}},
+	}
+	for _, tt := range tests {
+		b := Ball{Params: params, Time: tt.time, Position: tt.pos, Velocity: tt.vel}
+		got := instantaneousState(b, params.Gravity)
+		w := tt.want
+		if got.Time != w.Time || got.Velocity != w.Velocity {
+			t.Errorf("%s: time %v, velocity %v, want %v, %v", tt.name, got.Time, got.Velocity, w.Time, w.Velocity)
+		}
+		if !approxEqual(got.Position.X, w.Position.X, 1e-9) || !approxEqual(got.Position.Y, w.Position.Y, 1e-9) {
+			t.Errorf("%s: position %v m, want %v m", tt.name, got.Position, w.Position)
+		}
+		if !approxEqual(got.Speed, w.Speed, 1e-9) || !approxEqual(got.TravelAngle, w.TravelAngle, 1e-4) {
+			t.Errorf("%s: speed %v, travel angle %v°, want %v, %v°", tt.name, got.Speed, got.TravelAngle, w.Speed, w.TravelAngle)
+		}
+		if !approxEqual(got.Accel.X, w.Accel.X, 1e-9) || !approxEqual(got.Accel.Y, w.Accel.Y, 1e-9) {
+			t.Errorf("%s: acceleration %v, want %v", tt.name, got.Accel, w.Accel)
+		}
+		if !approxEqual(got.KE, w.KE, 1e-9) || !approxEqual(got.PE, w.PE, 1e-9) {
+			t.Errorf("%s: KE %v J, PE %v J, want %v, %v", tt.name, got.KE, got.PE, w.KE, w.PE)
+		}
+	}
+}
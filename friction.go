@@ -0,0 +1,36 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// frictionAccel returns the rolling friction acceleration in m/s², which has
+// magnitude mu*g and points against the horizontal motion
+func frictionAccel(vel Vector2, mu, gravity float64) Vector2 {
+	if vel.X == 0 {
+		return Vector2{}
+	}
+	return Vector2{-math.Copysign(mu*gravity, vel.X), 0}
+}
+
+// drawFrictionArrow draws the friction acceleration acting on the rolling ball
+func (g *Game) drawFrictionArrow(screen *ebiten.Image) {
+	a := frictionAccel(g.ball.Velocity, g.friction, g.gravity)
+	if a.X == 0 {
+		return
+	}
+
+	// 10 px per m/s² keeps typical friction visible next to the ball
+	from := g.ball.Position
+	to := from.Add(Vector2{a.X * 10, 0})
+	arrowColor := color.RGBA{255, 60, 60, 255}
+	strokeLine(screen, float32(from.X), float32(from.Y), float32(to.X), float32(to.Y), 3, arrowColor, g.antialias)
+
+	// Arrowhead
+	dir := math.Copysign(1, a.X)
+	strokeLine(screen, float32(to.X), float32(to.Y), float32(to.X-dir*6), float32(to.Y-5), 3, arrowColor, g.antialias)
+	strokeLine(screen, float32(to.X), float32(to.Y), float32(to.X-dir*6), float32(to.Y+5), 3, arrowColor, g.antialias)
+}
@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestFrictionAccel(t *testing.T) {
+	tests := []struct {
+		vel  Vector2
+		want Vector2
+	}{
+		{Vector2{5, 0}, Vector2{-0.3 * defaultGravity, 0}},
+		{Vector2{-2, 0}, Vector2{0.3 * defaultGravity, 0}},
+		{Vector2{0.01, 0}, Vector2{-0.3 * defaultGravity, 0}},
+		{Vector2{0, 0}, Vector2{}},
+	}
+	for _, tt := range tests {
+		got := frictionAccel(tt.vel, 0.3, defaultGravity)
+		if !approxEqual(got.X, tt.want.X, 1e-9) || got.Y != tt.want.Y {
+			t.Errorf("frictionAccel(%v) = %v, want %v", tt.vel, got, tt.want)
+		}
+		if got.X*tt.vel.X > 0 {
+			t.Errorf("frictionAccel(%v) = %v points along the motion", tt.vel, got)
+		}
+	}
+}
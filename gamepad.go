@@ -0,0 +1,42 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const stickDeadZone = 0.2 // stick deflection ignored as noise
+
+// gamepadIntent reads every connected gamepad with a standard layout. The left
+// stick's direction sets the angle and its deflection the power, the bottom
+// face button launches and the right face button resets.
+func (g *Game) gamepadIntent() InputIntent {
+	var in InputIntent
+	g.gamepadIDs = ebiten.AppendGamepadIDs(g.gamepadIDs[:0])
+	for _, id := range g.gamepadIDs {
+		if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+			continue
+		}
+
+		x := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+		y := -ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+		if mag := math.Hypot(x, y); mag > stickDeadZone {
+			angle := math.Max(minAimAngle, math.Min(maxAimAngle, math.Atan2(y, x)*180/math.Pi))
+			k := math.Min(1, (mag-stickDeadZone)/(1-stickDeadZone))
+			in = mergeIntents(in, InputIntent{
+				AngleDelta: angle - g.aimAngle,
+				PowerDelta: minPower + k*(maxPower-minPower) - g.aimPower,
+			})
+		}
+
+		if inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonRightBottom) {
+			in.Launch = true
+		}
+		if inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonRightRight) {
+			in.Reset = true
+		}
+	}
+	return in
+}
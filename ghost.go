@@ -0,0 +1,31 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const ghostSampleStride = 4 // physics samples per ghost point, about one per frame
+
+// shotPath thins a shot's samples down to every stride-th position, always
+// keeping the last one so the path ends where the ball stopped
+func shotPath(samples []ShotSample, stride int) []Vector2 {
+	var path []Vector2
+	for i := 0; i < len(samples); i += stride {
+		path = append(path, samples[i].Pos)
+	}
+	if n := len(samples); n > 0 && (n-1)%stride != 0 {
+		path = append(path, samples[n-1].Pos)
+	}
+	return path
+}
+
+// drawGhost draws the path of the previous shot as a faint line to compare against
+func (g *Game) drawGhost(screen *ebiten.Image) {
+	ghostColor := color.RGBA{255, 255, 255, 70}
+	for i := 1; i < len(g.previousTrail); i++ {
+		a, b := g.previousTrail[i-1], g.previousTrail[i]
+		strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 2, ghostColor, g.antialias)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShotPath(t *testing.T) {
+	samples := make([]ShotSample, 10)
+	for i := range samples {
+		samples[i].Pos = Vector2{float64(i), 0}
+	}
+	tests := []struct {
+		n, stride int
+		want      []float64 // X of each kept position
+	}{
+		{10, 1, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{10, 4, []float64{0, 4, 8, 9}}, // the last sample is always kept
+		{9, 4, []float64{0, 4, 8}},
+		{1, 4, []float64{0}},
+		{0, 4, nil},
+	}
+	for _, tt := range tests {
+		var got []float64
+		for _, p := range shotPath(samples[:tt.n], tt.stride) {
+			got = append(got, p.X)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("shotPath of %d samples, stride %d = %v, want %v", tt.n, tt.stride, got, tt.want)
+		}
+	}
+}
+
+func TestPreviousTrailUpdatedOnLanding(t *testing.T) {
+	g := newTestGame()
+	g.targets, g.obstacles = nil, nil
+	var prev []Vector2
+	for _, angle := range []float64{30, 60, 45} {
+		g.ResetBall()
+		g.aimAngle, g.aimPower = angle, 12
+		g.launch()
+		for i := 0; !g.ball.Landed; i++ {
+			if i > 20/physicsDt {
+				t.Fatalf("%v° shot never landed", angle)
+			}
+			if i == 30 && !reflect.DeepEqual(g.previousTrail, prev) {
+				t.Errorf("%v° shot: previousTrail changed mid-flight", angle)
+			}
+			g.step(physicsDt)
+		}
+		if len(g.previousTrail) < 2 || reflect.DeepEqual(g.previousTrail, prev) {
+			t.Fatalf("%v° shot: previousTrail not replaced on landing", angle)
+		}
+		if end := g.previousTrail[len(g.previousTrail)-1]; end != g.ball.Position {
+			t.Errorf("%v° shot: ghost ends at %v, want where the ball landed at %v", angle, end, g.ball.Position)
+		}
+		prev = g.previousTrail
+	}
+}
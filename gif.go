@@ -0,0 +1,105 @@
+package main
+
+import (
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	gifFrameSkip = 3   // capture every third frame, 20 fps at 60 TPS
+	gifScale     = 0.5 // frames are stored at half the screen size
+	maxGIFFrames = 200 // ten seconds of flight
+)
+
+// EncodeGIF writes frames as a looping animated GIF showing each frame for delayMs milliseconds
+func EncodeGIF(frames []*image.Paletted, delayMs int, w io.Writer) error {
+	anim := &gif.GIF{}
+	for _, f := range frames {
+		anim.Image = append(anim.Image, f)
+		anim.Delay = append(anim.Delay, delayMs/10) // GIF delays are in hundredths of a second
+	}
+	return gif.EncodeAll(w, anim)
+}
+
+// toPaletted converts a captured frame to the web-safe palette GIF frames use
+func toPaletted(img *image.RGBA) *image.Paletted {
+	p := image.NewPaletted(img.Bounds(), palette.Plan9)
+	draw.Draw(p, p.Bounds(), img, img.Bounds().Min, draw.Src)
+	return p
+}
+
+// toggleGIFRecording arms or disarms recording the next shot's flight
+func (g *Game) toggleGIFRecording() {
+	g.gifArmed = !g.gifArmed
+	g.gifFrames = nil
+	if g.gifArmed {
+		g.flash("GIF: recording the next shot")
+	} else {
+		g.flash("GIF: recording cancelled")
+	}
+}
+
+// captureGIFFrame grabs a downscaled copy of the rendered frame while the ball
+// flies, and hands the frames off for encoding once the shot is over
+func (g *Game) captureGIFFrame(screen *ebiten.Image) {
+	if !g.gifArmed {
+		return
+	}
+	flying := g.ball.Launched && !g.ball.Landed
+	if flying && len(g.gifFrames) < maxGIFFrames && g.ticks%gifFrameSkip == 0 {
+		w, h := int(screenWidth*gifScale), int(screenHeight*gifScale)
+		if g.gifImage == nil {
+			g.gifImage = ebiten.NewImage(w, h)
+		}
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(gifScale, gifScale)
+		op.Filter = ebiten.FilterLinear
+		g.gifImage.DrawImage(screen, op)
+
+		frame := image.NewRGBA(image.Rect(0, 0, w, h))
+		g.gifImage.ReadPixels(frame.Pix)
+		g.gifFrames = append(g.gifFrames, frame)
+	}
+
+	if !flying && len(g.gifFrames) > 0 {
+		frames := g.gifFrames
+		g.gifFrames = nil
+		g.gifArmed = false
+		path := captureName("shot", "gif", time.Now())
+		g.flash("GIF: saving " + path)
+
+		// Palette conversion is slow, so it runs off the game loop
+		go func() {
+			if err := saveGIF(frames, path); err != nil {
+				log.Printf("saving %s: %v", path, err)
+				return
+			}
+			log.Printf("saved %s", path)
+		}()
+	}
+}
+
+func saveGIF(frames []*image.RGBA, path string) error {
+	paletted := make([]*image.Paletted, len(frames))
+	for i, f := range frames {
+		paletted[i] = toPaletted(f)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := EncodeGIF(paletted, gifFrameSkip*1000/60, f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
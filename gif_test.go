@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"testing"
+)
+
+// syntheticFrames returns n 16x8 frames, each filled with its own palette color
+func syntheticFrames(n int) []*image.Paletted {
+	frames := make([]*image.Paletted, n)
+	for i := range frames {
+		f := image.NewPaletted(image.Rect(0, 0, 16, 8), palette.Plan9)
+		for j := range f.Pix {
+			f.Pix[j] = uint8(i * 10)
+		}
+		frames[i] = f
+	}
+	return frames
+}
+
+func TestEncodeGIF(t *testing.T) {
+	tests := []struct {
+		frames, delayMs, want int // want is the decoded delay in hundredths
+	}{
+		{1, 100, 10},
+		{3, 50, 5},
+		{10, 20, 2},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := EncodeGIF(syntheticFrames(tt.frames), tt.delayMs, &buf); err != nil {
+			t.Fatalf("EncodeGIF(%d frames): %v", tt.frames, err)
+		}
+		anim, err := gif.DecodeAll(&buf)
+		if err != nil {
+			t.Fatalf("decoding %d frames: %v", tt.frames, err)
+		}
+		if len(anim.Image) != tt.frames {
+			t.Fatalf("decoded %d frames, want %d", len(anim.Image), tt.frames)
+		}
+		for i, f := range anim.Image {
+			if anim.Delay[i] != tt.want {
+				t.Errorf("%d frames at %d ms: frame %d delay = %d, want %d", tt.frames, tt.delayMs, i, anim.Delay[i], tt.want)
+			}
+			if f.Bounds() != image.Rect(0, 0, 16, 8) {
+				t.Errorf("frame %d bounds = %v", i, f.Bounds())
+			}
+			want := color.RGBAModel.Convert(palette.Plan9[i*10])
+			if got := color.RGBAModel.Convert(f.At(3, 3)); got != want {
+				t.Errorf("frame %d color = %v, want %v", i, got, want)
+			}
+		}
+	}
+
+	if err := EncodeGIF(nil, 50, &bytes.Buffer{}); err == nil {
+		t.Errorf("EncodeGIF with no frames returned no error")
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+// StateHash returns a stable checksum of the simulation state. Any change to
+// the physics or scoring rules shows up as a different hash for the same run.
+func (g *Game) StateHash() uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	writeFloat := func(f float64) {
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(f))
+		h.Write(buf[:])
+	}
+	writeInt := func(i int) {
+		binary.LittleEndian.PutUint64(buf[:], uint64(i))
+		h.Write(buf[:])
+	}
+	writeBool := func(v bool) {
+		if v {
+			writeInt(1)
+		} else {
+			writeInt(0)
+		}
+	}
+
+	writeFloat(g.aimAngle)
+	writeFloat(g.aimPower)
+	writeFloat(g.gravity)
+	writeInt(g.level)
+	writeInt(g.score)
+	writeInt(g.attempts)
+
+	b := g.ball
+	writeBool(b.Launched)
+	writeBool(b.Landed)
+	writeInt(b.Bounces)
+	writeFloat(b.Time)
+	writeFloat(b.Position.X)
+	writeFloat(b.Position.Y)
+	writeFloat(b.Velocity.X)
+	writeFloat(b.Velocity.Y)
+	writeInt(len(b.Trail))
+
+	writeInt(len(g.targets))
+	for _, t := range g.targets {
+		writeFloat(t.Position.X)
+		writeFloat(t.Position.Y)
+		writeInt(t.HP)
+	}
+
+	return h.Sum64()
+}
+
+// Scenario is a canned, input-free run used for regression checks
+type Scenario struct {
+	Angle  float64
+	Power  float64
+	Frames int
+}
+
+// RunScenario launches a fresh game with the default config and the scenario's
+// aim and steps it frame by frame, folding every frame's StateHash into the
+// returned checksum. It reads no files, so local settings cannot change it.
+func RunScenario(s Scenario) uint64 {
+	g := newGameWithConfig(defaultSeed, DefaultConfig())
+	g.aimAngle = s.Angle
+	g.aimPower = s.Power
+	g.launch()
+
+	h := fnv.New64a()
+	var buf [8]byte
+	for i := 0; i < s.Frames; i++ {
+		for n := g.clock.Advance(1.0 / 60.0 * g.timeScale); n > 0; n-- {
+			g.step(physicsDt)
+		}
+		binary.LittleEndian.PutUint64(buf[:], g.StateHash())
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
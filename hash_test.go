@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestRunScenarioHash(t *testing.T) {
+	tests := []struct {
+		s    Scenario
+		want uint64
+	}{
+		{Scenario{Angle: 45, Power: 12, Frames: 240}, 0xaea4d6596107e63a},
+		{Scenario{Angle: 30, Power: 20, Frames: 300}, 0x5ef4ae705fa46e9e},
+		{Scenario{Angle: 80, Power: 8, Frames: 120}, 0x710f16bb173e08c5},
+	}
+	for _, tt := range tests {
+		got := RunScenario(tt.s)
+		if got != tt.want {
+			t.Errorf("RunScenario(%+v) = %#x, want %#x", tt.s, got, tt.want)
+		}
+	}
+}
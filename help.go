@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// Binding is one line of the help overlay: an input and what it does
+type Binding struct {
+	Input string
+	Help  string
+}
+
+// directBindings are the inputs handled outside the action keymap
+var directBindings = []Binding{
+	{"Click", "Select target / recenter minimap"},
+	{"Shift+Click", "Measure distance"},
+	{"Ctrl+Click", "Protractor: vertex, then both arms"},
+	{"Esc", "Clear measurements"},
+	{"Drag slider", "Angle, power, gravity, wind"},
+	{"Mouse wheel", "Scroll shot log"},
+	{"Left/Right (paused)", "Scrub last shot"},
+	{"Gamepad stick", "Aim"},
+	{"Gamepad A/B", "Launch / reset game"},
+}
+
+// keybindings lists every input, the action keymap first
+func keybindings(keys []keyBinding) []Binding {
+	bindings := make([]Binding, 0, len(keys)+len(directBindings))
+	for _, b := range keys {
+		bindings = append(bindings, Binding{b.Key.String(), b.Help})
+	}
+	return append(bindings, directBindings...)
+}
+
+// helpLines formats the bindings for the help overlay
+func helpLines(keys []keyBinding) []string {
+	bindings := keybindings(keys)
+	lines := make([]string, len(bindings))
+	for i, b := range bindings {
+		lines[i] = fmt.Sprintf("%-14s %s", b.Input, b.Help)
+	}
+	return lines
+}
+
+// drawHelp draws every binding in two columns over the middle of the screen
+func (g *Game) drawHelp(screen *ebiten.Image) {
+	const columnWidth = 330
+	lines := helpLines(g.allBindings())
+	rows := (len(lines) + 1) / 2
+	w, h := 2*columnWidth+20, rows*panelLineHeight+40
+	x, y := (screenWidth-w)/2, (screenHeight-h)/2
+
+	fillRect(screen, float32(x), float32(y), float32(w), float32(h), color.RGBA{0, 0, 0, 200}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, "CONTROLS (H to close)", x+10, y+8)
+	for i, line := range lines {
+		col, row := i/rows, i%rows
+		ebitenutil.DebugPrintAt(screen, line, x+10+col*columnWidth, y+30+row*panelLineHeight)
+	}
+}
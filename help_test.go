@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestHelpListsEveryBinding(t *testing.T) {
+	g := newTestGame()
+	lines := helpLines(g.allBindings())
+	text := strings.Join(lines, "\n")
+
+	var want []Binding
+	for _, keys := range [][]keyBinding{keyBindings, directKeyBindings} {
+		for _, b := range keys {
+			want = append(want, Binding{b.Key.String(), b.Help})
+		}
+	}
+	want = append(want, directBindings...)
+
+	if len(lines) != len(want) {
+		t.Errorf("help has %d lines, want one for each of %d bindings", len(lines), len(want))
+	}
+	for _, b := range want {
+		if !strings.Contains(text, b.Input) || !strings.Contains(text, b.Help) {
+			t.Errorf("help is missing %s: %s", b.Input, b.Help)
+		}
+	}
+}
+
+func TestHelpLineFormat(t *testing.T) {
+	tests := []struct {
+		key  keyBinding
+		want string
+	}{
+		{keyBinding{ActionLaunch, ebiten.KeySpace, false, "Launch"}, "Space          Launch"},
+		{keyBinding{ActionHelp, ebiten.KeyH, false, "Help"}, "H              Help"},
+	}
+	for _, tt := range tests {
+		lines := helpLines([]keyBinding{tt.key})
+		if lines[0] != tt.want {
+			t.Errorf("help line for %v = %q, want %q", tt.key.Key, lines[0], tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+)
+
+const highScorePath = "highscore.json"
+
+// HighScore holds the records kept between sessions. FewestAttempts is the
+// fewest shots taken to clear a level, 0 until a level has been cleared.
+type HighScore struct {
+	BestScore      int `json:"best_score"`
+	FewestAttempts int `json:"fewest_attempts"`
+}
+
+// LoadHighScore reads the records, returning empty ones on the first run when there is no file
+func LoadHighScore(path string) (HighScore, error) {
+	var hs HighScore
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return hs, nil
+	}
+	if err != nil {
+		return hs, err
+	}
+	err = json.Unmarshal(data, &hs)
+	return hs, err
+}
+
+func SaveHighScore(path string, hs HighScore) error {
+	data, err := json.MarshalIndent(hs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Improve merges a finished game's score and fewest shots per level into the
+// records. Records only ever get better; it reports whether any changed.
+func (hs HighScore) Improve(score, attempts int) (HighScore, bool) {
+	improved := false
+	if score > hs.BestScore {
+		hs.BestScore = score
+		improved = true
+	}
+	if attempts > 0 && (hs.FewestAttempts == 0 || attempts < hs.FewestAttempts) {
+		hs.FewestAttempts = attempts
+		improved = true
+	}
+	return hs, improved
+}
+
+// saveHighScore merges the current game into the records and writes them out
+// if any improved, so they survive however the session ends
+func (g *Game) saveHighScore() {
+	hs, improved := g.highScore.Improve(g.score, g.fewestShots)
+	if !improved {
+		return
+	}
+	g.highScore = hs
+	if err := SaveHighScore(highScorePath, hs); err != nil {
+		log.Printf("saving high score: %v", err)
+	}
+}
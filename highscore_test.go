@@ -0,0 +1,46 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestHighScoreFirstRun(t *testing.T) {
+	hs, err := LoadHighScore(filepath.Join(t.TempDir(), "highscore.json"))
+	if err != nil || hs != (HighScore{}) {
+		t.Errorf("LoadHighScore with no file = %+v, %v, want empty records and no error", hs, err)
+	}
+}
+
+func TestHighScoreImprove(t *testing.T) {
+	tests := []struct {
+		name            string
+		have            HighScore
+		score, attempts int
+		want            HighScore
+		improved        bool
+	}{
+		{"first game", HighScore{}, 120, 5, HighScore{120, 5}, true},
+		{"first game without clearing a level", HighScore{}, 40, 0, HighScore{40, 0}, true},
+		{"better score", HighScore{120, 5}, 150, 6, HighScore{150, 5}, true},
+		{"fewer attempts", HighScore{120, 5}, 90, 3, HighScore{120, 3}, true},
+		{"both better", HighScore{120, 5}, 200, 2, HighScore{200, 2}, true},
+		{"worse", HighScore{120, 5}, 100, 7, HighScore{120, 5}, false},
+		{"tied", HighScore{120, 5}, 120, 5, HighScore{120, 5}, false},
+		{"no level cleared keeps the record", HighScore{120, 5}, 10, 0, HighScore{120, 5}, false},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "highscore.json")
+		if err := SaveHighScore(path, tt.have); err != nil {
+			t.Fatalf("%s: SaveHighScore: %v", tt.name, err)
+		}
+		loaded, err := LoadHighScore(path)
+		if err != nil || loaded != tt.have {
+			t.Fatalf("%s: LoadHighScore = %+v, %v, want %+v", tt.name, loaded, err, tt.have)
+		}
+		got, improved := loaded.Improve(tt.score, tt.attempts)
+		if got != tt.want || improved != tt.improved {
+			t.Errorf("%s: Improve(%d, %d) = %+v, %v, want %+v, %v", tt.name, tt.score, tt.attempts, got, improved, tt.want, tt.improved)
+		}
+	}
+}
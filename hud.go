@@ -0,0 +1,68 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	minHUDScale  = 1.0
+	maxHUDScale  = 1.25 // the left panel is about 580 px tall at 1x and has to fit the 800 px screen
+	hudScaleStep = 0.25
+
+	panelWidth      = 300
+	panelLineHeight = 15
+)
+
+// hudPanelSize returns the on-screen size of a text panel with the given number of lines at a HUD scale
+func hudPanelSize(lines int, scale float64) (w, h float64) {
+	return panelWidth * scale, float64(lines*panelLineHeight+20) * scale
+}
+
+// uiPanelHeight returns the unscaled height of the left panel holding the
+// given number of sliders above lines of text
+func uiPanelHeight(lines, sliders int) float64 {
+	_, h := hudPanelSize(lines, 1)
+	return h + float64(sliders*sliderRowHeight)
+}
+
+// clampHUDScale limits a HUD scale, such as one read from the config file, to the supported range
+func clampHUDScale(scale float64) float64 {
+	return math.Max(minHUDScale, math.Min(maxHUDScale, scale))
+}
+
+// drawHUD renders the UI at its logical size, which shrinks as the HUD scale
+// grows, then scales it up. maxHUDScale keeps the logical size large enough
+// for the left panel. The debug font can't be resized, so this is how the
+// HUD gets larger.
+func (g *Game) drawHUD(dst *ebiten.Image) {
+	scale := g.config.HUDScale
+	w, h := int(math.Ceil(screenWidth/scale)), int(math.Ceil(screenHeight/scale))
+	if g.hudImage == nil || g.hudImage.Bounds().Dx() != w || g.hudImage.Bounds().Dy() != h {
+		if g.hudImage != nil {
+			g.hudImage.Deallocate()
+		}
+		g.hudImage = ebiten.NewImage(w, h)
+	}
+
+	g.hudImage.Clear()
+	g.drawUI(g.hudImage)
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.Filter = ebiten.FilterLinear
+	dst.DrawImage(g.hudImage, op)
+}
+
+// changeHUDScale adjusts the HUD scale by delta steps and saves it to the config
+func (g *Game) changeHUDScale(delta float64) {
+	scale := clampHUDScale(g.config.HUDScale + delta*hudScaleStep)
+	if scale == g.config.HUDScale {
+		return
+	}
+	g.config.HUDScale = scale
+	if err := SaveConfig(configPath, g.config); err != nil {
+		g.flash("Could not save config: " + err.Error())
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import "testing"
+
+func TestHUDPanelSize(t *testing.T) {
+	tests := []struct {
+		lines int
+		scale float64
+		w, h  float64
+	}{
+		{10, 1, 300, 170},
+		{10, 2, 600, 340},
+		{4, 1.5, 450, 120},
+		{0, 1, 300, 20},
+	}
+	for _, tt := range tests {
+		if w, h := hudPanelSize(tt.lines, tt.scale); w != tt.w || h != tt.h {
+			t.Errorf("hudPanelSize(%d, %v) = %v x %v, want %v x %v", tt.lines, tt.scale, w, h, tt.w, tt.h)
+		}
+	}
+
+	// The whole left panel, which starts 10 px down, fits at the largest scale
+	g := newTestGame()
+	bottom := (10 + uiPanelHeight(len(g.uiTexts()), len(g.sliders()))) * maxHUDScale
+	if bottom > screenHeight {
+		t.Errorf("at HUD scale %v the left panel reaches %v px, past the %v px screen", maxHUDScale, bottom, screenHeight)
+	}
+}
+
+func TestChangeHUDScale(t *testing.T) {
+	tests := []struct {
+		from, delta, want float64
+	}{
+		{1, 1, 1.25},
+		{1, -1, minHUDScale},
+		{1, 4, maxHUDScale},
+		{1.25, -2, minHUDScale},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.config.HUDScale = tt.from
+		g.changeHUDScale(tt.delta)
+		if g.config.HUDScale != tt.want {
+			t.Errorf("scale %v changed by %v steps = %v, want %v", tt.from, tt.delta, g.config.HUDScale, tt.want)
+		}
+	}
+}
+
+func TestConfigHUDScaleClamped(t *testing.T) {
+	tests := []struct {
+		saved, want float64
+	}{
+		{1, 1},
+		{1.25, 1.25},
+		{2.5, maxHUDScale},
+		{0, minHUDScale},
+	}
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		cfg.HUDScale = tt.saved
+		if got := newGameWithConfig(defaultSeed, cfg).config.HUDScale; got != tt.want {
+			t.Errorf("saved HUD scale %v loaded as %v, want %v", tt.saved, got, tt.want)
+		}
+	}
+}
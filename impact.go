@@ -0,0 +1,9 @@
+package main
+
+import "math"
+
+// impactInfo returns the speed in m/s and the angle in degrees below the
+// horizontal of a ball hitting the ground with vel (Y up)
+func impactInfo(vel Vector2) (speed, angleDeg float64) {
+	return vel.Magnitude(), math.Atan2(-vel.Y, math.Abs(vel.X)) * 180 / math.Pi
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestImpactInfo(t *testing.T) {
+	tests := []struct {
+		vel          Vector2
+		speed, angle float64
+	}{
+		{Vector2{3, -4}, 5, 53.13},
+		{Vector2{-3, -4}, 5, 53.13}, // flying left lands just as steeply
+		{Vector2{10, -10}, math.Sqrt2 * 10, 45},
+		{Vector2{0, -7}, 7, 90},
+		{Vector2{6, 0}, 6, 0},
+	}
+	for _, tt := range tests {
+		speed, angle := impactInfo(tt.vel)
+		if !approxEqual(speed, tt.speed, 1e-9) || !approxEqual(angle, tt.angle, 0.01) {
+			t.Errorf("impactInfo(%v) = %v, %v°, want %v, %v°", tt.vel, speed, angle, tt.speed, tt.angle)
+		}
+	}
+}
+
+func TestImpactMirrorsLaunch(t *testing.T) {
+	for _, power := range []float64{8, 12, 20} {
+		params := vacuumParams(45, power)
+		pos, vel := params.Start, params.InitialVelocity()
+		for i := 0; vel.Y > 0 || pos.Y < params.Start.Y; i++ {
+			if i > 10/physicsDt {
+				t.Fatalf("%v m/s shot never came back down", power)
+			}
+			pos, vel = params.Step(pos, vel, float64(i)*physicsDt, physicsDt)
+		}
+		speed, angle := impactInfo(vel)
+		if !approxEqual(speed, power, 0.05) || !approxEqual(angle, 45, 0.5) {
+			t.Errorf("45° at %v m/s landed at %.2f m/s, %.2f°, want %v m/s, 45°", power, speed, angle, power)
+		}
+	}
+}
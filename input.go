@@ -0,0 +1,446 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Action is a single player command. The names are used in saved macros.
+type Action string
+
+const (
+	ActionLaunch        Action = "launch"
+	ActionAimUp         Action = "aim_up"
+	ActionAimDown       Action = "aim_down"
+	ActionPowerUp       Action = "power_up"
+	ActionPowerDown     Action = "power_down"
+	ActionToggleTrail   Action = "toggle_trail"
+	ActionTrimMode      Action = "trim_mode"
+	ActionToggleBounce  Action = "toggle_bounce"
+	ActionBounceLimit   Action = "bounce_limit"
+	ActionToggleDodge   Action = "toggle_dodge"
+	ActionWindUp        Action = "wind_up"
+	ActionWindDown      Action = "wind_down"
+	ActionToggleGusts   Action = "toggle_gusts"
+	ActionToggleRocket  Action = "toggle_rocket"
+	ActionTwoStage      Action = "two_stage"
+	ActionWallLeft      Action = "wall_left"
+	ActionWallTop       Action = "wall_top"
+	ActionWallRight     Action = "wall_right"
+	ActionNextTarget    Action = "next_target"
+	ActionAutoAim       Action = "auto_aim"
+	ActionAssistUp      Action = "assist_up"
+	ActionAssistDown    Action = "assist_down"
+	ActionReplay        Action = "replay"
+	ActionReplayOverlay Action = "replay_overlay"
+	ActionToggleFan     Action = "toggle_fan"
+	ActionComponents    Action = "toggle_components"
+	ActionProjectile    Action = "projectile"
+	ActionSpinBack      Action = "spin_back"
+	ActionSpinTop       Action = "spin_top"
+	ActionRaiseCannon   Action = "raise_cannon"
+	ActionLowerCannon   Action = "lower_cannon"
+	ActionToggleVectors Action = "toggle_vectors"
+	ActionFollow        Action = "follow"
+	ActionPinPreview    Action = "pin_preview"
+	ActionUnpinPreview  Action = "unpin_preview"
+	ActionCinematic     Action = "cinematic"
+	ActionPause         Action = "pause"
+	ActionReset         Action = "reset"
+	ActionResetBall     Action = "reset_ball"
+	ActionHelp          Action = "help"
+	ActionBallBigger    Action = "ball_bigger"
+	ActionBallSmaller   Action = "ball_smaller"
+	ActionIntegrator    Action = "integrator"
+	ActionAntialias     Action = "antialias"
+	ActionRangePlot     Action = "range_plot"
+	ActionTraceMode     Action = "trace_mode"
+	ActionClearTrace    Action = "clear_trace"
+	ActionSpreadCount   Action = "spread_count"
+	ActionSpreadAngle   Action = "spread_angle"
+	ActionEditor        Action = "editor"
+	ActionChargeMode    Action = "charge_mode"
+	ActionCollision     Action = "collision_debug"
+	ActionFreezeFrame   Action = "freeze_frame"
+	ActionCoriolis      Action = "coriolis"
+	ActionCompareDrag   Action = "compare_drag"
+	ActionSnapAim       Action = "snap_aim"
+	ActionChargeRelease Action = "charge_release" // releasing Space in charge mode, not bound to a key
+
+	// Keys Update reads directly rather than through pollActions
+	ActionTypeEntry   Action = "type_entry"
+	ActionSavePreset  Action = "save_preset"
+	ActionPreset1     Action = "preset_1"
+	ActionPreset2     Action = "preset_2"
+	ActionPreset3     Action = "preset_3"
+	ActionPreset4     Action = "preset_4"
+	ActionPreset5     Action = "preset_5"
+	ActionPreset6     Action = "preset_6"
+	ActionPreset7     Action = "preset_7"
+	ActionPreset8     Action = "preset_8"
+	ActionPreset9     Action = "preset_9"
+	ActionHUDSmaller  Action = "hud_smaller"
+	ActionHUDBigger   Action = "hud_bigger"
+	ActionPhotoMode   Action = "photo_mode"
+	ActionDiagnostics Action = "diagnostics"
+	ActionMeterAxes   Action = "meter_axes"
+	ActionRecordMacro Action = "record_macro"
+	ActionPlayMacro   Action = "play_macro"
+	ActionRecordGIF   Action = "record_gif"
+	ActionScreenshot  Action = "screenshot"
+)
+
+// keyBinding ties an action to a key. Held actions repeat every frame the key
+// is down. Help describes the action in the help overlay.
+type keyBinding struct {
+	Action Action
+	Key    ebiten.Key
+	Held   bool
+	Help   string
+}
+
+var keyBindings = []keyBinding{
+	{ActionLaunch, ebiten.KeySpace, false, "Launch / reset ball (stage in two-stage mode)"},
+	{ActionChargeMode, ebiten.KeyBackquote, false, "Charge mode (hold Space)"},
+	{ActionAimUp, ebiten.KeyArrowUp, true, "Aim up"},
+	{ActionAimDown, ebiten.KeyArrowDown, true, "Aim down"},
+	{ActionSnapAim, ebiten.KeyCapsLock, false, "Snap aim to common angles"},
+	{ActionPowerUp, ebiten.KeyArrowRight, true, "More power"},
+	{ActionPowerDown, ebiten.KeyArrowLeft, true, "Less power"},
+	{ActionToggleTrail, ebiten.KeyT, false, "Toggle trail"},
+	{ActionTrimMode, ebiten.KeyM, false, "Trail trim mode"},
+	{ActionToggleBounce, ebiten.KeyB, false, "Toggle bounce"},
+	{ActionBounceLimit, ebiten.KeyL, false, "Bounce limit"},
+	{ActionToggleDodge, ebiten.KeyD, false, "Dodging targets"},
+	{ActionWindUp, ebiten.KeyBracketRight, false, "Wind right"},
+	{ActionWindDown, ebiten.KeyBracketLeft, false, "Wind left"},
+	{ActionToggleGusts, ebiten.KeyG, false, "Toggle gusts"},
+	{ActionToggleRocket, ebiten.KeyK, false, "Rocket mode"},
+	{ActionTwoStage, ebiten.KeyF5, false, "Two-stage mode"},
+	{ActionWallLeft, ebiten.KeyQ, false, "Left wall"},
+	{ActionWallTop, ebiten.KeyW, false, "Ceiling"},
+	{ActionWallRight, ebiten.KeyX, false, "Right wall"},
+	{ActionEditor, ebiten.KeyE, false, "Target editor"},
+	{ActionNextTarget, ebiten.KeyTab, false, "Select next target"},
+	{ActionAutoAim, ebiten.KeyA, false, "Auto-aim"},
+	{ActionAssistUp, ebiten.KeyEqual, false, "More aim assist"},
+	{ActionAssistDown, ebiten.KeyMinus, false, "Less aim assist"},
+	{ActionReplay, ebiten.KeyY, false, "Replay last shot"},
+	{ActionReplayOverlay, ebiten.KeyO, false, "Replay overlays"},
+	{ActionToggleFan, ebiten.KeyF, false, "Trajectory fan"},
+	{ActionTraceMode, ebiten.KeySemicolon, false, "Trace mode"},
+	{ActionClearTrace, ebiten.KeyQuote, false, "Clear trace"},
+	{ActionRangePlot, ebiten.KeyF11, false, "Range vs angle plot"},
+	{ActionComponents, ebiten.KeyZ, false, "Velocity components"},
+	{ActionProjectile, ebiten.KeyN, false, "Next projectile"},
+	{ActionSpreadCount, ebiten.KeyBackslash, false, "Balls per launch"},
+	{ActionSpreadAngle, ebiten.KeySlash, false, "Spread angle"},
+	{ActionBallBigger, ebiten.KeyInsert, false, "Bigger ball"},
+	{ActionBallSmaller, ebiten.KeyDelete, false, "Smaller ball"},
+	{ActionIntegrator, ebiten.KeyF9, false, "Next integrator"},
+	{ActionCoriolis, ebiten.KeyScrollLock, false, "Coriolis deflection"},
+	{ActionCompareDrag, ebiten.KeyNumLock, false, "Vacuum vs drag paths"},
+	{ActionSpinBack, ebiten.KeyHome, false, "More backspin"},
+	{ActionSpinTop, ebiten.KeyEnd, false, "More topspin"},
+	{ActionRaiseCannon, ebiten.KeyPageUp, false, "Raise cannon"},
+	{ActionLowerCannon, ebiten.KeyPageDown, false, "Lower cannon"},
+	{ActionToggleVectors, ebiten.KeyV, false, "Toggle vectors and preview"},
+	{ActionFollow, ebiten.KeyJ, false, "Camera follow"},
+	{ActionPinPreview, ebiten.KeyI, false, "Pin preview"},
+	{ActionUnpinPreview, ebiten.KeyU, false, "Unpin preview"},
+	{ActionCinematic, ebiten.KeyC, false, "Hide HUD"},
+	{ActionCollision, ebiten.KeyF1, false, "Collision shapes"},
+	{ActionAntialias, ebiten.KeyF10, false, "Anti-aliasing"},
+	{ActionPause, ebiten.KeyP, false, "Pause"},
+	{ActionFreezeFrame, ebiten.KeyPause, false, "Freeze frame"},
+	{ActionReset, ebiten.KeyR, false, "Reset game"},
+	{ActionResetBall, ebiten.KeyBackspace, false, "Reset ball"},
+	{ActionHelp, ebiten.KeyH, false, "Help"},
+}
+
+// directKeyBindings are keys Update checks itself instead of polling them as
+// actions, because they act before the rest of the frame's input or must not
+// end up in a macro. The keymap remaps them like the others.
+var directKeyBindings = []keyBinding{
+	{ActionTypeEntry, ebiten.KeyEnter, false, "Type angle & power"},
+	{ActionSavePreset, ebiten.KeyS, false, "Save shot preset"},
+	{ActionPreset1, ebiten.KeyDigit1, false, "Load shot preset 1"},
+	{ActionPreset2, ebiten.KeyDigit2, false, "Load shot preset 2"},
+	{ActionPreset3, ebiten.KeyDigit3, false, "Load shot preset 3"},
+	{ActionPreset4, ebiten.KeyDigit4, false, "Load shot preset 4"},
+	{ActionPreset5, ebiten.KeyDigit5, false, "Load shot preset 5"},
+	{ActionPreset6, ebiten.KeyDigit6, false, "Load shot preset 6"},
+	{ActionPreset7, ebiten.KeyDigit7, false, "Load shot preset 7"},
+	{ActionPreset8, ebiten.KeyDigit8, false, "Load shot preset 8"},
+	{ActionPreset9, ebiten.KeyDigit9, false, "Load shot preset 9"},
+	{ActionHUDSmaller, ebiten.KeyComma, false, "Smaller HUD"},
+	{ActionHUDBigger, ebiten.KeyPeriod, false, "Bigger HUD"},
+	{ActionPhotoMode, ebiten.KeyF2, false, "Photo mode"},
+	{ActionDiagnostics, ebiten.KeyF3, false, "Diagnostics"},
+	{ActionMeterAxes, ebiten.KeyF4, false, "Meter axes"},
+	{ActionRecordMacro, ebiten.KeyF6, false, "Record macro"},
+	{ActionPlayMacro, ebiten.KeyF7, false, "Play macro"},
+	{ActionRecordGIF, ebiten.KeyF8, false, "Record next shot as GIF"},
+	{ActionScreenshot, ebiten.KeyF12, false, "Screenshot"},
+}
+
+// pollActions returns the actions the bindings trigger from the keyboard this frame
+func pollActions(bindings []keyBinding) []Action {
+	var actions []Action
+	for _, b := range bindings {
+		if (b.Held && ebiten.IsKeyPressed(b.Key)) || (!b.Held && inpututil.IsKeyJustPressed(b.Key)) {
+			actions = append(actions, b.Action)
+		}
+	}
+	return actions
+}
+
+// applyAction carries out one action. Keyboard input and macro playback both come through here.
+func (g *Game) applyAction(a Action) {
+	switch a {
+	case ActionToggleTrail:
+		g.showTrail = !g.showTrail
+	case ActionTrimMode:
+		g.ball.TrimMode = g.ball.TrimMode.Next()
+		g.ball.trimTrail()
+	case ActionToggleBounce:
+		g.bounce = !g.bounce
+	case ActionBounceLimit:
+		g.bounceLimit = nextBounceLimit(g.bounceLimit)
+	case ActionToggleDodge:
+		g.dodgeMode = !g.dodgeMode
+	case ActionWindUp:
+		g.wind.Base = math.Min(maxWind, g.wind.Base+windStep)
+	case ActionWindDown:
+		g.wind.Base = math.Max(-maxWind, g.wind.Base-windStep)
+	case ActionToggleGusts:
+		g.wind.Gusts = !g.wind.Gusts
+	case ActionToggleRocket:
+		if g.rocket == nil {
+			r := defaultRocket
+			g.rocket = &r
+		} else {
+			g.rocket = nil
+		}
+	case ActionTwoStage:
+		g.twoStage = !g.twoStage
+	case ActionWallLeft:
+		g.walls.Left = !g.walls.Left
+	case ActionWallTop:
+		g.walls.Top = !g.walls.Top
+	case ActionWallRight:
+		g.walls.Right = !g.walls.Right
+	case ActionChargeMode:
+		g.chargeMode = !g.chargeMode
+		g.charging = false
+	case ActionEditor:
+		g.toggleEditor()
+	case ActionNextTarget:
+		if len(g.targets) > 0 {
+			g.activeTarget = (g.activeTarget + 1) % len(g.targets)
+		}
+	case ActionAutoAim:
+		if !g.ball.Launched {
+			g.autoAim()
+		}
+	case ActionAssistUp:
+		g.aimAssist = math.Min(1, math.Round(g.aimAssist*10+1)/10)
+	case ActionAssistDown:
+		g.aimAssist = math.Max(0, math.Round(g.aimAssist*10-1)/10)
+	case ActionReplay:
+		g.startReplay()
+	case ActionReplayOverlay:
+		g.replayOverlay = !g.replayOverlay
+	case ActionProjectile:
+		if !g.ball.Launched || g.ball.Landed {
+			g.projectile = nextProjectile(g.projectile)
+			g.ball.Radius = projectiles[g.projectile].Radius
+			g.ball.Color = projectiles[g.projectile].Color
+		}
+	case ActionSpreadCount:
+		if !g.ball.Launched {
+			g.spreadCount = nextSpreadCount(g.spreadCount)
+		}
+	case ActionSpreadAngle:
+		if !g.ball.Launched {
+			g.spreadAngle = nextSpreadAngle(g.spreadAngle)
+		}
+	case ActionBallBigger:
+		if !g.ball.Launched {
+			g.ball.Radius = math.Min(maxBallRadius, g.ball.Radius+ballRadiusStep)
+		}
+	case ActionBallSmaller:
+		if !g.ball.Launched {
+			g.ball.Radius = math.Max(minBallRadius, g.ball.Radius-ballRadiusStep)
+		}
+	case ActionIntegrator:
+		if !g.ball.Launched {
+			g.integrator = nextIntegrator(g.integrator)
+		}
+	case ActionChargeRelease:
+		if !g.ball.Launched && !g.editing {
+			g.launch()
+		}
+	case ActionCoriolis:
+		g.coriolis = !g.coriolis
+	case ActionCompareDrag:
+		g.compareDrag = !g.compareDrag
+	case ActionSnapAim:
+		g.snapAim = !g.snapAim
+	case ActionSpinBack:
+		if !g.ball.Launched {
+			g.spin = math.Min(maxSpin, g.spin+spinStep)
+		}
+	case ActionSpinTop:
+		if !g.ball.Launched {
+			g.spin = math.Max(-maxSpin, g.spin-spinStep)
+		}
+	case ActionRaiseCannon:
+		if !g.ball.Launched {
+			g.setPlatformHeight(g.launchHeight + platformStep)
+		}
+	case ActionLowerCannon:
+		if !g.ball.Launched {
+			g.setPlatformHeight(g.launchHeight - platformStep)
+		}
+	case ActionComponents:
+		g.components = !g.components
+	case ActionRangePlot:
+		g.showRangePlot = !g.showRangePlot
+	case ActionTraceMode:
+		g.traceMode = !g.traceMode
+	case ActionClearTrace:
+		g.trace = nil
+	case ActionToggleFan:
+		g.showFan = !g.showFan
+	case ActionToggleVectors:
+		g.showVectors = !g.showVectors
+	case ActionPinPreview:
+		if !g.ball.Launched {
+			g.pinnedPreview, _ = g.predictedPath(g.launchAngle())
+		}
+	case ActionUnpinPreview:
+		g.pinnedPreview = nil
+	case ActionFollow:
+		g.follow = !g.follow
+	case ActionCinematic:
+		g.cinematic = !g.cinematic
+	case ActionCollision:
+		g.showCollision = !g.showCollision
+	case ActionAntialias:
+		g.antialias = !g.antialias
+	case ActionResetBall:
+		if !g.paused {
+			g.ResetBall()
+		}
+	case ActionPause:
+		g.paused = !g.paused
+		g.freezeFrame = false
+	case ActionFreezeFrame:
+		g.toggleFreezeFrame()
+	case ActionHelp:
+		g.showHelp = !g.showHelp
+	}
+}
+
+// InputIntent is what an input source asks for this frame in terms of aiming,
+// launching and resetting. Each source produces one and they are merged, so
+// keyboard and gamepad can be used at the same time.
+type InputIntent struct {
+	AngleDelta float64 // degrees
+	PowerDelta float64 // m/s
+	Launch     bool
+	Reset      bool
+}
+
+// mergeIntents adds up the aim changes of every intent and launches or resets if any of them asks to
+func mergeIntents(intents ...InputIntent) InputIntent {
+	var merged InputIntent
+	for _, in := range intents {
+		merged.AngleDelta += in.AngleDelta
+		merged.PowerDelta += in.PowerDelta
+		merged.Launch = merged.Launch || in.Launch
+		merged.Reset = merged.Reset || in.Reset
+	}
+	return merged
+}
+
+// actionIntent collects the aiming, launch and reset actions into an intent
+func actionIntent(actions []Action) InputIntent {
+	var in InputIntent
+	for _, a := range actions {
+		switch a {
+		case ActionAimUp:
+			in.AngleDelta += 1
+		case ActionAimDown:
+			in.AngleDelta -= 1
+		case ActionPowerUp:
+			in.PowerDelta += 0.5
+		case ActionPowerDown:
+			in.PowerDelta -= 0.5
+		case ActionLaunch:
+			in.Launch = true
+		case ActionReset:
+			in.Reset = true
+		}
+	}
+	return in
+}
+
+// resetConfirmWindow is how soon a second reset request must follow the first to reset the game
+const resetConfirmWindow = 2 * time.Second
+
+// confirmReset decides whether a reset requested at now goes ahead, given
+// when the pending request was made (zero if none). It returns the pending
+// request time to keep: cleared after a reset, now when this one is the first.
+func confirmReset(pending, now time.Time) (reset bool, nextPending time.Time) {
+	if !pending.IsZero() && now.Sub(pending) <= resetConfirmWindow {
+		return true, time.Time{}
+	}
+	return false, now
+}
+
+// requestReset resets the game on the second request within resetConfirmWindow
+func (g *Game) requestReset(now time.Time) {
+	reset, pending := confirmReset(g.resetPending, now)
+	g.resetPending = pending
+	if reset {
+		g.reset()
+		return
+	}
+	g.flash("Press R again to reset")
+}
+
+// applyIntent aims, then launches the ball or sends a landed one back to the cannon, or resets the game
+func (g *Game) applyIntent(in InputIntent) {
+	if in.Reset {
+		g.requestReset(time.Now())
+		return
+	}
+	if g.paused {
+		return
+	}
+
+	g.aimAngle = math.Max(minAimAngle, math.Min(maxAimAngle, g.aimAngle+in.AngleDelta))
+	if g.snapAim && in.AngleDelta != 0 {
+		g.aimAngle = snapAngle(g.aimAngle, snapThreshold, commonAngles)
+	}
+	g.aimPower = math.Max(minPower, math.Min(maxPower, g.aimPower+in.PowerDelta))
+
+	if in.Launch && !g.editing {
+		if !g.ball.Launched {
+			// In charge mode releasing Space launches instead
+			if !g.chargeMode {
+				g.launch()
+			}
+		} else if g.twoStage && !g.ball.Landed && !g.ball.Staged {
+			// In two-stage mode the first press in flight separates the booster
+			g.stage()
+		} else {
+			g.ResetBall()
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMergeIntents(t *testing.T) {
+	keys := InputIntent{AngleDelta: 1, PowerDelta: -0.5}
+	pad := InputIntent{AngleDelta: 0.25, PowerDelta: 0.2, Launch: true}
+	reset := InputIntent{Reset: true}
+	tests := []struct {
+		name    string
+		intents []InputIntent
+		want    InputIntent
+	}{
+		{"nothing", nil, InputIntent{}},
+		{"one source passes through", []InputIntent{pad}, pad},
+		{"aim changes add up", []InputIntent{keys, pad}, InputIntent{AngleDelta: 1.25, PowerDelta: -0.3, Launch: true}},
+		{"opposing sources cancel", []InputIntent{keys, {AngleDelta: -1, PowerDelta: 0.5}}, InputIntent{}},
+		{"any source can reset", []InputIntent{keys, reset, {}}, InputIntent{AngleDelta: 1, PowerDelta: -0.5, Reset: true}},
+		{"launch survives a source without it", []InputIntent{pad, {}}, pad},
+	}
+	for _, tt := range tests {
+		got := mergeIntents(tt.intents...)
+		if !approxEqual(got.AngleDelta, tt.want.AngleDelta, 1e-9) || !approxEqual(got.PowerDelta, tt.want.PowerDelta, 1e-9) ||
+			got.Launch != tt.want.Launch || got.Reset != tt.want.Reset {
+			t.Errorf("%s: mergeIntents = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestActionIntent(t *testing.T) {
+	tests := []struct {
+		actions []Action
+		want    InputIntent
+	}{
+		{[]Action{ActionAimUp, ActionAimUp, ActionPowerDown}, InputIntent{AngleDelta: 2, PowerDelta: -0.5}},
+		{[]Action{ActionAimDown, ActionPowerUp, ActionLaunch}, InputIntent{AngleDelta: -1, PowerDelta: 0.5, Launch: true}},
+		{[]Action{ActionReset}, InputIntent{Reset: true}},
+	}
+	for _, tt := range tests {
+		if got := actionIntent(tt.actions); got != tt.want {
+			t.Errorf("actionIntent(%v) = %+v, want %+v", tt.actions, got, tt.want)
+		}
+	}
+}
+
+func TestPinPreviewSnapshot(t *testing.T) {
+	tests := []struct {
+		angle, power float64
+	}{
+		{45, 12},
+		{30, 15},
+		{70, 10},
+		{20, 8},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles, g.terrain = nil, nil, nil
+		g.aimAngle, g.aimPower = tt.angle, tt.power
+		g.applyAction(ActionPinPreview)
+
+		// The path holds the launch point, one point per previewDt while the
+		// ball is up and the ground contact, a radius above the launch point
+		vy := tt.power * math.Sin(tt.angle*math.Pi/180)
+		rise := g.ball.Radius / g.scale
+		flight := (vy + math.Sqrt(vy*vy-2*g.gravity*rise)) / g.gravity
+		want := int(flight/previewDt) + 2
+		if len(g.pinnedPreview) != want {
+			t.Errorf("pinning %v° at %v m/s captured %d points, want %d", tt.angle, tt.power, len(g.pinnedPreview), want)
+		}
+		if len(g.pinnedPreview) > 0 && g.pinnedPreview[0] != g.cannon {
+			t.Errorf("pinning %v° at %v m/s: path starts at %v, want the cannon at %v", tt.angle, tt.power, g.pinnedPreview[0], g.cannon)
+		}
+
+		g.applyAction(ActionUnpinPreview)
+		if g.pinnedPreview != nil {
+			t.Errorf("unpinning left %d points", len(g.pinnedPreview))
+		}
+	}
+}
+
+func TestAntialiasToggle(t *testing.T) {
+	g := newTestGame()
+	if !g.antialias {
+		t.Fatalf("anti-aliasing starts off, want it on by default")
+	}
+	for _, want := range []bool{false, true, false} {
+		g.applyAction(ActionAntialias)
+		if g.antialias != want {
+			t.Errorf("after toggling, antialias = %v, want %v", g.antialias, want)
+		}
+	}
+}
+
+func TestConfirmReset(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		presses []time.Duration // after start
+		resets  []bool
+	}{
+		{"single press", []time.Duration{0}, []bool{false}},
+		{"second press in time", []time.Duration{0, time.Second}, []bool{false, true}},
+		{"second press at the edge", []time.Duration{0, resetConfirmWindow}, []bool{false, true}},
+		{"second press too late", []time.Duration{0, resetConfirmWindow + time.Millisecond}, []bool{false, false}},
+		{"late press arms a new window", []time.Duration{0, 3 * time.Second, 4 * time.Second}, []bool{false, false, true}},
+		{"a reset needs two new presses", []time.Duration{0, time.Second, 1500 * time.Millisecond}, []bool{false, true, false}},
+	}
+	for _, tt := range tests {
+		var pending time.Time
+		for i, d := range tt.presses {
+			var reset bool
+			reset, pending = confirmReset(pending, start.Add(d))
+			if reset != tt.resets[i] {
+				t.Errorf("%s: press %d reset = %v, want %v", tt.name, i+1, reset, tt.resets[i])
+			}
+		}
+	}
+}
+
+func TestRequestResetNeedsConfirmation(t *testing.T) {
+	g := newTestGame()
+	g.score = 50
+	now := time.Now()
+	g.requestReset(now)
+	if g.score != 50 || g.resetPending != now {
+		t.Fatalf("first request: score %d, pending %v, want the score kept and the request pending", g.score, g.resetPending)
+	}
+	g.requestReset(now.Add(time.Second))
+	if g.score != 0 || !g.resetPending.IsZero() {
+		t.Errorf("second request: score %d, pending %v, want a fresh game", g.score, g.resetPending)
+	}
+}
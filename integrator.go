@@ -0,0 +1,78 @@
+package main
+
+import "fmt"
+
+// Integrator advances a position in pixels and a velocity in m/s by dt
+// seconds, starting t seconds into the flight of p
+type Integrator interface {
+	Name() string
+	Step(p LaunchParams, pos, vel Vector2, t, dt float64) (Vector2, Vector2)
+}
+
+// integrators are the methods cycled through in game, the default first
+var integrators = []Integrator{ClosedForm{}, Euler{}, RK4{}}
+
+// nextIntegrator cycles through the integrators
+func nextIntegrator(index int) int {
+	return (index + 1) % len(integrators)
+}
+
+// ClosedForm applies the constant-acceleration equations over each step. It
+// is exact in a vacuum, where the acceleration never changes.
+type ClosedForm struct{}
+
+func (ClosedForm) Name() string { return "Closed form" }
+
+func (ClosedForm) Step(p LaunchParams, pos, vel Vector2, t, dt float64) (Vector2, Vector2) {
+	a := p.Accel(t, vel)
+	return displace(pos, vel.Scale(dt).Add(a.Scale(0.5*dt*dt)), p.Scale), vel.Add(a.Scale(dt))
+}
+
+// Euler is the explicit Euler method: both position and velocity follow the
+// rates at the start of the step, so it drifts outward from the true arc
+type Euler struct{}
+
+func (Euler) Name() string { return "Euler" }
+
+func (Euler) Step(p LaunchParams, pos, vel Vector2, t, dt float64) (Vector2, Vector2) {
+	a := p.Accel(t, vel)
+	return displace(pos, vel.Scale(dt), p.Scale), vel.Add(a.Scale(dt))
+}
+
+// RK4 is the classic fourth-order Runge-Kutta method
+type RK4 struct{}
+
+func (RK4) Name() string { return "RK4" }
+
+func (RK4) Step(p LaunchParams, pos, vel Vector2, t, dt float64) (Vector2, Vector2) {
+	k1 := p.Accel(t, vel)
+	v2 := vel.Add(k1.Scale(dt / 2))
+	k2 := p.Accel(t+dt/2, v2)
+	v3 := vel.Add(k2.Scale(dt / 2))
+	k3 := p.Accel(t+dt/2, v3)
+	v4 := vel.Add(k3.Scale(dt))
+	k4 := p.Accel(t+dt, v4)
+
+	dx := vel.Add(v2.Scale(2)).Add(v3.Scale(2)).Add(v4).Scale(dt / 6)
+	dv := k1.Add(k2.Scale(2)).Add(k3.Scale(2)).Add(k4).Scale(dt / 6)
+	return displace(pos, dx, p.Scale), vel.Add(dv)
+}
+
+// displace moves a screen position by a displacement in meters with Y up
+func displace(pos, d Vector2, scale float64) Vector2 {
+	return Vector2{pos.X + d.X*scale, pos.Y - d.Y*scale}
+}
+
+// integratorText names the integrator in use and, while the ball flies
+// through a vacuum on its first arc, how far it is from the closed-form
+// solution PositionAt
+func (g *Game) integratorText() string {
+	name := integrators[g.integrator].Name()
+	b := g.ball
+	if !b.Launched || b.Landed || b.Bounces > 0 || b.Rolling || !g.vacuumFlight() {
+		return fmt.Sprintf("Integrator: %s", name)
+	}
+	exact := b.Params.PositionAt(b.Time)
+	err := b.Position.Add(exact.Scale(-1)).Magnitude() / b.Params.Scale
+	return fmt.Sprintf("Integrator: %s (error %.2g m)", name, err)
+}
@@ -0,0 +1,59 @@
+package main
+
+import "testing"
+
+// flyWith integrates a launch for steps steps of dt and returns the error
+// against the closed-form PositionAt, in meters
+func flyWith(in Integrator, params LaunchParams, dt float64, steps int) float64 {
+	params.Integrator = in
+	pos, vel := params.Start, params.InitialVelocity()
+	for i := 0; i < steps; i++ {
+		pos, vel = params.Step(pos, vel, float64(i)*dt, dt)
+	}
+	exact := params.PositionAt(float64(steps) * dt)
+	return pos.Add(exact.Scale(-1)).Magnitude() / params.Scale
+}
+
+func TestIntegratorsAgainstAnalytic(t *testing.T) {
+	const dt, steps = 1.0 / 60.0, 120
+	tests := []struct {
+		angle, power float64
+	}{
+		{30, 10},
+		{45, 15},
+		{75, 20},
+	}
+	for _, tt := range tests {
+		params := vacuumParams(tt.angle, tt.power)
+		if err := flyWith(RK4{}, params, dt, steps); err > 1e-9 {
+			t.Errorf("RK4 %v° at %v m/s: %.3g m off the analytic arc", tt.angle, tt.power, err)
+		}
+		if err := flyWith(ClosedForm{}, params, dt, steps); err > 1e-9 {
+			t.Errorf("closed form %v° at %v m/s: %.3g m off the analytic arc", tt.angle, tt.power, err)
+		}
+		// Euler lags gravity by one step each step, falling g·T·dt/2 short
+		want := params.Gravity * steps * dt * dt / 2
+		if err := flyWith(Euler{}, params, dt, steps); !approxEqual(err, want, 1e-6) {
+			t.Errorf("Euler %v° at %v m/s: %.4f m off the analytic arc, want %.4f", tt.angle, tt.power, err, want)
+		}
+	}
+}
+
+func TestEulerErrorShrinksWithStep(t *testing.T) {
+	params := vacuumParams(45, 12)
+	coarse := flyWith(Euler{}, params, 1.0/30.0, 60)
+	fine := flyWith(Euler{}, params, 1.0/120.0, 240)
+	if !approxEqual(coarse/fine, 4, 1e-6) {
+		t.Errorf("Euler over 2 s: %.4f m at 1/30 s, %.4f m at 1/120 s, want a quarter of the error", coarse, fine)
+	}
+}
+
+func TestNextIntegratorCycles(t *testing.T) {
+	i := 0
+	for range integrators {
+		i = nextIntegrator(i)
+	}
+	if i != 0 {
+		t.Errorf("cycling through %d integrators ended at %d, want 0", len(integrators), i)
+	}
+}
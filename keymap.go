@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// parseKey turns a key name such as "Space", "R" or "ArrowUp" into a key.
+// Names are the ones the help overlay shows and are not case-sensitive.
+func parseKey(name string) (ebiten.Key, error) {
+	var k ebiten.Key
+	if err := k.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+		return 0, fmt.Errorf("unknown key %q", name)
+	}
+	return k, nil
+}
+
+// remapKeys returns a copy of bindings with the keys keymap assigns to action
+// names replaced. Unknown actions and key names are reported and leave the
+// default binding in place.
+func remapKeys(bindings []keyBinding, keymap map[string]string) ([]keyBinding, []error) {
+	remapped := append([]keyBinding(nil), bindings...)
+	var errs []error
+	for action, name := range keymap {
+		key, err := parseKey(name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("keymap %s: %w", action, err))
+			continue
+		}
+		found := false
+		for i := range remapped {
+			if remapped[i].Action == Action(action) {
+				remapped[i].Key = key
+				found = true
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Errorf("keymap: unknown action %q", action))
+		}
+	}
+	return remapped, errs
+}
+
+// keyFor returns the key bound to an action after remapping, and whether
+// any is
+func (g *Game) keyFor(a Action) (ebiten.Key, bool) {
+	for _, b := range g.allBindings() {
+		if b.Action == a {
+			return b.Key, true
+		}
+	}
+	return 0, false
+}
+
+// justPressed reports whether the key bound to an action went down this frame
+func (g *Game) justPressed(a Action) bool {
+	key, ok := g.keyFor(a)
+	return ok && inpututil.IsKeyJustPressed(key)
+}
+
+// pressed reports whether the key bound to an action is down
+func (g *Game) pressed(a Action) bool {
+	key, ok := g.keyFor(a)
+	return ok && ebiten.IsKeyPressed(key)
+}
+
+// allBindings lists the polled and the direct key bindings together
+func (g *Game) allBindings() []keyBinding {
+	all := make([]keyBinding, 0, len(g.bindings)+len(g.directKeys))
+	return append(append(all, g.bindings...), g.directKeys...)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    ebiten.Key
+		wantErr bool
+	}{
+		{"Space", ebiten.KeySpace, false},
+		{"R", ebiten.KeyR, false},
+		{"ArrowUp", ebiten.KeyArrowUp, false},
+		{"arrowup", ebiten.KeyArrowUp, false}, // not case-sensitive
+		{" F9 ", ebiten.KeyF9, false},
+		{"Digit1", ebiten.KeyDigit1, false},
+		{"", 0, true},
+		{"Spacebar", 0, true},
+		{"F99", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseKey(tt.name)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+			t.Errorf("parseKey(%q) = %v, %v, want %v, error %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestRemapKeys(t *testing.T) {
+	defaults := []keyBinding{
+		{ActionLaunch, ebiten.KeySpace, false, "Launch"},
+		{ActionReset, ebiten.KeyR, false, "Reset"},
+	}
+	tests := []struct {
+		name   string
+		keymap map[string]string
+		want   []ebiten.Key
+		errs   int
+	}{
+		{"no keymap", nil, []ebiten.Key{ebiten.KeySpace, ebiten.KeyR}, 0},
+		{"launch on Enter", map[string]string{"launch": "Enter"}, []ebiten.Key{ebiten.KeyEnter, ebiten.KeyR}, 0},
+		{"both remapped", map[string]string{"launch": "J", "reset": "K"}, []ebiten.Key{ebiten.KeyJ, ebiten.KeyK}, 0},
+		{"bad key name", map[string]string{"launch": "Nope"}, []ebiten.Key{ebiten.KeySpace, ebiten.KeyR}, 1},
+		{"unknown action", map[string]string{"fly": "F"}, []ebiten.Key{ebiten.KeySpace, ebiten.KeyR}, 1},
+	}
+	for _, tt := range tests {
+		got, errs := remapKeys(defaults, tt.keymap)
+		if len(errs) != tt.errs {
+			t.Errorf("%s: %d errors %v, want %d", tt.name, len(errs), errs, tt.errs)
+		}
+		for i, b := range got {
+			if b.Key != tt.want[i] {
+				t.Errorf("%s: %s bound to %v, want %v", tt.name, b.Action, b.Key, tt.want[i])
+			}
+		}
+		if defaults[0].Key != ebiten.KeySpace || defaults[1].Key != ebiten.KeyR {
+			t.Fatalf("%s: remapKeys changed the default bindings", tt.name)
+		}
+	}
+}
+
+func TestKeymapReachesDirectKeys(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Keymap = map[string]string{string(ActionScreenshot): "P", string(ActionLaunch): "Enter"}
+	g := newGameWithConfig(defaultSeed, cfg)
+	tests := []struct {
+		action Action
+		want   ebiten.Key
+	}{
+		{ActionScreenshot, ebiten.KeyP},
+		{ActionLaunch, ebiten.KeyEnter},
+		{ActionReset, ebiten.KeyR},
+	}
+	for _, tt := range tests {
+		if got, ok := g.keyFor(tt.action); !ok || got != tt.want {
+			t.Errorf("keyFor(%s) = %v, %v, want %v", tt.action, got, ok, tt.want)
+		}
+	}
+	if len(g.bindings) != len(keyBindings) || len(g.directKeys) != len(directKeyBindings) {
+		t.Errorf("remapping left %d polled and %d direct bindings, want %d and %d",
+			len(g.bindings), len(g.directKeys), len(keyBindings), len(directKeyBindings))
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// flightTimeAndRange solves the vacuum flight of a shot launched launchHeight
+// meters above where it lands, returning the time of flight in seconds and
+// the horizontal range in meters
+func flightTimeAndRange(angle, power, gravity, launchHeight float64) (t, rng float64) {
+	angleRad := angle * math.Pi / 180.0
+	vx, vy := power*math.Cos(angleRad), power*math.Sin(angleRad)
+	t = (vy + math.Sqrt(vy*vy+2*gravity*launchHeight)) / gravity
+	return t, vx * t
+}
+
+// vacuumFlight reports whether a shot from the current settings follows the
+// analytic arc, with nothing but gravity acting on it
+func (g *Game) vacuumFlight() bool {
+	p := g.currentProjectile()
+	return g.wind.Base == 0 && !g.wind.Gusts && g.rocket == nil && g.spin == 0 && g.coriolisRate() == 0 &&
+		(p.DragCoeff == 0 || g.config.AirDensity == 0)
+}
+
+// landingTimeAndRange returns the time of flight and range of a predicted
+// path of points, one per dt seconds up to the last, which is where the path
+// meets the ground part way through a step. A vacuum shot is solved exactly.
+func (g *Game) landingTimeAndRange(points []Vector2, dt float64, hit Hit) (t, rng float64) {
+	if g.vacuumFlight() {
+		// The ball's center lands a little above the ground line
+		height := metersFromPixels(hit.Point.Y-g.cannon.Y, g.scale)
+		return flightTimeAndRange(g.launchAngle(), g.aimPower, g.gravity, height)
+	}
+
+	rng = metersFromPixels(hit.Point.X-g.cannon.X, g.scale)
+	n := len(points)
+	t = float64(n-1) * dt
+	if n >= 3 {
+		// The last step covers about the same distance as the one before it
+		last := points[n-1].Add(points[n-2].Scale(-1)).Magnitude()
+		full := points[n-2].Add(points[n-3].Scale(-1)).Magnitude()
+		if full > 0 {
+			t = (float64(n-2) + math.Min(1, last/full)) * dt
+		}
+	}
+	return t, rng
+}
+
+// drawLandingMarker labels where a predicted path of points, one per dt,
+// meets the ground with the range and time of flight
+func (g *Game) drawLandingMarker(screen *ebiten.Image, points []Vector2, dt float64, hit Hit) {
+	if hit.Kind != HitGround {
+		return
+	}
+	t, rng := g.landingTimeAndRange(points, dt, hit)
+
+	x, y := float32(hit.Point.X), float32(g.terrain.SurfaceY(hit.Point.X))
+	markColor := color.RGBA{255, 255, 255, 230}
+	strokeLine(screen, x, y-14, x, y+6, 2, markColor, g.antialias)
+	strokeLine(screen, x-6, y, x+6, y, 2, markColor, g.antialias)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.2f m, %.2f s", rng, t), int(x)-40, int(y)+8)
+}
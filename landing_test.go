@@ -0,0 +1,84 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFlightTimeAndRange(t *testing.T) {
+	tests := []struct {
+		angle, power, gravity, height float64
+		t, rng                        float64
+	}{
+		{45, 10, 9.8, 0, 1.443075, 10.204082},  // v²/g on level ground
+		{0, 10, 9.8, 4.9, 1, 10},               // dropped a second's fall
+		{30, 20, 9.8, 10, 2.775985, 48.081466}, // from a 10 m cliff
+		{60, 15, 9.8, 2, 2.797026, 20.977693},
+		{-20, 10, 9.8, 8, 0.975558, 9.167246}, // aimed down off a platform
+		{45, 10, 1.62, 0, 8.729713, 61.728395},
+	}
+	for _, tt := range tests {
+		gotT, gotR := flightTimeAndRange(tt.angle, tt.power, tt.gravity, tt.height)
+		if !approxEqual(gotT, tt.t, 1e-5) || !approxEqual(gotR, tt.rng, 1e-5) {
+			t.Errorf("flightTimeAndRange(%v, %v, %v, %v) = %v s, %v m, want %v s, %v m",
+				tt.angle, tt.power, tt.gravity, tt.height, gotT, gotR, tt.t, tt.rng)
+		}
+	}
+}
+
+func TestFlightTimeAndRangeMatchesSimulation(t *testing.T) {
+	for _, height := range []float64{0, 3, 8} {
+		params := vacuumParams(40, 12)
+		ground := params.Start.Y + height*params.Scale
+		pos, vel := params.Start, params.InitialVelocity()
+		steps := 0
+		for ; pos.Y < ground || vel.Y > 0; steps++ {
+			pos, vel = params.Step(pos, vel, float64(steps)*physicsDt, physicsDt)
+		}
+		wantT, wantR := flightTimeAndRange(40, 12, params.Gravity, height)
+		if gotT := float64(steps) * physicsDt; math.Abs(gotT-wantT) > physicsDt {
+			t.Errorf("from %v m: simulated flight %.3f s, want %.3f s", height, gotT, wantT)
+		}
+		if gotR := (pos.X - params.Start.X) / params.Scale; math.Abs(gotR-wantR) > 12*physicsDt {
+			t.Errorf("from %v m: simulated range %.3f m, want %.3f m", height, gotR, wantR)
+		}
+	}
+}
+
+func TestLandingTimeMatchesFlight(t *testing.T) {
+	tests := []struct {
+		angle, power float64
+		projectile   int
+		dt           float64 // seconds between preview points
+	}{
+		{40, 12, 0, previewDt},
+		{40, 12, 0, 1.0 / 30.0},
+		{50, 18, 2, previewDt},
+		{50, 18, 2, 1.0 / 30.0},
+		{30, 25, 2, 1.0 / 120.0},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles, g.terrain = nil, nil, nil
+		g.aimAngle, g.aimPower = tt.angle, tt.power
+		g.projectile = tt.projectile
+		g.ball.Radius = projectiles[tt.projectile].Radius
+		params := g.launchParams()
+		points, hit := traceTrajectory(params, nil, nil, g.walls, nil, tt.dt, 10)
+		gotT, gotR := g.landingTimeAndRange(points, tt.dt, hit)
+
+		g.launch()
+		for i := 0; float64(i) < 10/physicsDt && !g.ball.Landed; i++ {
+			g.step(physicsDt)
+		}
+		// The preview steps more coarsely than the flight, which drag makes
+		// show, so they agree to a couple of percent
+		name := projectiles[tt.projectile].Name
+		if math.Abs(gotT-g.ball.Time) > 0.02*g.ball.Time {
+			t.Errorf("%s at %v°, %v m/s, points every %.4f s: landing in %.3f s, the flight took %.3f s", name, tt.angle, tt.power, tt.dt, gotT, g.ball.Time)
+		}
+		if flown := metersFromPixels(g.ball.Position.X-g.cannon.X, g.scale); math.Abs(gotR-flown) > 0.02*flown {
+			t.Errorf("%s at %v°, %v m/s, points every %.4f s: range %.3f m, the flight went %.3f m", name, tt.angle, tt.power, tt.dt, gotR, flown)
+		}
+	}
+}
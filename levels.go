@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+const (
+	levelMinX    = 500.0 // targets stay right of the wall
+	levelMaxX    = screenWidth - 50.0
+	maxTargets   = 6
+	targetMoveUp = 20.0 // extra pixels per second of target speed per level from level 3
+	targetLift   = 30.0 // pixels between the ground and the lowest a target sits
+
+	minWindowSpeed   = 8.0  // m/s, speed windows fall between these
+	maxWindowSpeed   = 30.0 // m/s
+	speedWindowWidth = 6.0  // m/s
+)
+
+// GenerateTargets builds the target layout for a level. Higher levels push
+// targets farther out and higher, shrink them, give them more hitpoints and
+// impact speed windows and, from level 3, set them moving faster.
+func GenerateTargets(level int, rng *rand.Rand) []Target {
+	count := 2 + level
+	if count > maxTargets {
+		count = maxTargets
+	}
+
+	minX, maxHeight := levelTargetArea(level)
+	targets := make([]Target, 0, count)
+	for i := 0; i < count; i++ {
+		p := randomTargetSpot(rng, minX, maxHeight, nil)
+		hp := 1 + rng.Intn(1+level/2)
+		if hp > 3 {
+			hp = 3
+		}
+
+		t := NewTarget(p.X, p.Y, hp)
+		t.Radius = levelTargetRadius(level)
+		t.DescendingOnly = level >= 2 && rng.Float64() < 0.25
+		if level >= 2 && rng.Float64() < 0.2 {
+			t.MinSpeed = math.Round(minWindowSpeed + rng.Float64()*(maxWindowSpeed-minWindowSpeed-speedWindowWidth))
+			t.MaxSpeed = t.MinSpeed + speedWindowWidth
+		}
+
+		// The first target is always a standard one so every level has something to clear
+		if kind := rng.Float64(); i > 0 && kind < 0.15 {
+			t.Kind = TargetBonus
+		} else if i > 0 && level >= 2 && kind < 0.3 {
+			t.Kind = TargetPenalty
+		} else if i > 0 && level >= 2 && kind < 0.4 {
+			t.Kind = TargetSplitter
+		}
+
+		if level >= 3 {
+			speed := targetMoveUp * float64(level-2)
+			if rng.Intn(2) == 0 {
+				speed = -speed
+			}
+			t.Velocity = Vector2{speed, 0}
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// levelTargetArea returns how far left and how high above the ground
+// targets may sit on a level. Higher levels push them farther out and up.
+func levelTargetArea(level int) (minX, maxHeight float64) {
+	difficulty := float64(level - 1)
+	return math.Min(levelMinX+40*difficulty, levelMaxX-200), math.Min(80+30*difficulty, 400)
+}
+
+// randomTargetSpot picks a spot between minX and levelMaxX, from targetLift
+// to targetLift+maxHeight pixels above the terrain's surface there
+func randomTargetSpot(rng *rand.Rand, minX, maxHeight float64, terrain Terrain) Vector2 {
+	x := minX + rng.Float64()*(levelMaxX-minX)
+	return Vector2{x, terrain.SurfaceY(x) - targetLift - rng.Float64()*maxHeight}
+}
+
+// moveTargets slides moving targets back and forth across the target area
+func (g *Game) moveTargets(dt float64) {
+	for i := range g.targets {
+		t := &g.targets[i]
+		if t.Velocity.X == 0 && t.Velocity.Y == 0 {
+			continue
+		}
+		t.Position = t.Position.Add(t.Velocity.Scale(dt))
+		if (t.Position.X < levelMinX && t.Velocity.X < 0) || (t.Position.X > levelMaxX && t.Velocity.X > 0) {
+			t.Velocity.X = -t.Velocity.X
+		}
+	}
+}
+
+// nextLevel advances once every target but the penalty ones has been cleared
+func (g *Game) nextLevel() {
+	if shots := g.attempts - g.levelStart; g.fewestShots == 0 || shots < g.fewestShots {
+		g.fewestShots = shots
+	}
+	g.levelStart = g.attempts
+	g.level++
+	g.targets = GenerateTargets(g.level, g.rng)
+	g.activeTarget = 0
+	g.flash(fmt.Sprintf("Level %d", g.level))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerateTargetsDistanceGrowsWithLevel(t *testing.T) {
+	cannon := cannonPosition(0, defaultScale, nil)
+	meanDistance := func(level int) float64 {
+		total, n := 0.0, 0
+		for seed := int64(1); seed <= 200; seed++ {
+			for _, target := range GenerateTargets(level, rand.New(rand.NewSource(seed))) {
+				total += target.Position.Add(cannon.Scale(-1)).Magnitude()
+				n++
+			}
+		}
+		return total / float64(n)
+	}
+
+	levels := []int{1, 2, 4, 6, 8}
+	prev := 0.0
+	for _, level := range levels {
+		d := meanDistance(level)
+		if d <= prev {
+			t.Errorf("level %d: mean target distance %.1f px, not beyond the previous level's %.1f px", level, d, prev)
+		}
+		prev = d
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+)
+
+const macroPath = "macro.json"
+
+// MacroEvent is one piece of input recorded at a frame offset from the start
+// of the recording: an action, a gamepad intent, or the settings that input
+// outside the action keymap changed
+type MacroEvent struct {
+	Frame    int            `json:"frame"`
+	Action   Action         `json:"action,omitempty"`
+	Pad      *InputIntent   `json:"pad,omitempty"`
+	Settings *MacroSettings `json:"settings,omitempty"`
+}
+
+// MacroSettings are the aim and physics settings that sliders, typed entry,
+// presets, charging and target selection change directly instead of through
+// actions
+type MacroSettings struct {
+	Angle        float64 `json:"angle"`
+	Power        float64 `json:"power"`
+	Gravity      float64 `json:"gravity"`
+	Wind         float64 `json:"wind"`
+	Gusts        bool    `json:"gusts"`
+	TimeScale    float64 `json:"time_scale"`
+	ActiveTarget int     `json:"active_target"`
+}
+
+// MacroFrame is the input of one frame from every source
+type MacroFrame struct {
+	Settings MacroSettings
+	Actions  []Action
+	Pad      InputIntent
+}
+
+func (g *Game) macroSettings() MacroSettings {
+	return MacroSettings{
+		Angle:        g.aimAngle,
+		Power:        g.aimPower,
+		Gravity:      g.gravity,
+		Wind:         g.wind.Base,
+		Gusts:        g.wind.Gusts,
+		TimeScale:    g.timeScale,
+		ActiveTarget: g.activeTarget,
+	}
+}
+
+func (g *Game) applyMacroSettings(s MacroSettings) {
+	g.aimAngle, g.aimPower, g.gravity = s.Angle, s.Power, s.Gravity
+	g.wind.Base, g.wind.Gusts = s.Wind, s.Gusts
+	g.timeScale = s.TimeScale
+	g.activeTarget = clampTargetIndex(s.ActiveTarget, len(g.targets))
+}
+
+// Macro is a recorded input sequence. Length is the number of frames recorded,
+// so playback also reproduces the idle time after the last action.
+type Macro struct {
+	Events []MacroEvent `json:"events"`
+	Length int          `json:"length"`
+}
+
+type macroState int
+
+const (
+	macroIdle macroState = iota
+	macroRecording
+	macroPlaying
+)
+
+// MacroRecorder records input into a macro or feeds a macro back as input
+type MacroRecorder struct {
+	state    macroState
+	frame    int
+	next     int
+	macro    Macro
+	settings *MacroSettings // last settings recorded or played back
+}
+
+// process is called once per frame with the live input. While recording it
+// stores it, settings only when they changed; while playing it discards it
+// and returns the macro's input for this frame instead.
+func (r *MacroRecorder) process(in MacroFrame) MacroFrame {
+	switch r.state {
+	case macroRecording:
+		if r.settings == nil || *r.settings != in.Settings {
+			s := in.Settings
+			r.settings = &s
+			r.macro.Events = append(r.macro.Events, MacroEvent{Frame: r.frame, Settings: &s})
+		}
+		for _, a := range in.Actions {
+			r.macro.Events = append(r.macro.Events, MacroEvent{Frame: r.frame, Action: a})
+		}
+		if in.Pad != (InputIntent{}) {
+			pad := in.Pad
+			r.macro.Events = append(r.macro.Events, MacroEvent{Frame: r.frame, Pad: &pad})
+		}
+		r.frame++
+		r.macro.Length = r.frame
+	case macroPlaying:
+		live := in.Settings
+		in = MacroFrame{Settings: live}
+		for r.next < len(r.macro.Events) && r.macro.Events[r.next].Frame == r.frame {
+			e := r.macro.Events[r.next]
+			switch {
+			case e.Settings != nil:
+				r.settings = e.Settings
+			case e.Pad != nil:
+				in.Pad = mergeIntents(in.Pad, *e.Pad)
+			default:
+				in.Actions = append(in.Actions, e.Action)
+			}
+			r.next++
+		}
+		if r.settings != nil {
+			in.Settings = *r.settings
+		}
+		r.frame++
+		if r.frame >= r.macro.Length {
+			r.state = macroIdle
+		}
+	}
+	return in
+}
+
+// deterministic reports whether the recorder needs the simulation to advance
+// a fixed 1/60 s per frame, so playback reaches the recorded end state
+func (r *MacroRecorder) deterministic() bool {
+	return r.state != macroIdle
+}
+
+func (r *MacroRecorder) startRecording() {
+	r.state = macroRecording
+	r.frame = 0
+	r.macro = Macro{}
+	r.settings = nil
+}
+
+func (r *MacroRecorder) startPlayback(m Macro) {
+	r.state = macroPlaying
+	r.frame = 0
+	r.next = 0
+	r.macro = m
+	r.settings = nil
+}
+
+// toggleMacroRecording starts a recording from a fresh game, or stops and saves the current one
+func (g *Game) toggleMacroRecording() {
+	if g.macro.state == macroRecording {
+		g.macro.state = macroIdle
+		if err := SaveMacro(macroPath, g.macro.macro); err != nil {
+			g.flash("Could not save macro: " + err.Error())
+			return
+		}
+		g.flash("Macro saved to " + macroPath)
+		return
+	}
+	g.reset()
+	g.macro.startRecording()
+	g.flash("Recording macro, F6 to stop")
+}
+
+// playMacro resets the game and replays the saved macro so it ends in the same state it was recorded in
+func (g *Game) playMacro() {
+	m, err := LoadMacro(macroPath)
+	if err != nil {
+		g.flash("No macro to play")
+		return
+	}
+	g.reset()
+	g.macro.startPlayback(m)
+	g.flash("Playing macro")
+}
+
+func SaveMacro(path string, m Macro) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func LoadMacro(path string) (Macro, error) {
+	var m Macro
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
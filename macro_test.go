@@ -0,0 +1,72 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMacroReplaysToSameEndState(t *testing.T) {
+	type input struct {
+		frame   int
+		angle   float64 // set directly, as a slider would, when non-zero
+		actions []Action
+		pad     InputIntent
+	}
+	script := []input{
+		{frame: 0, angle: 60},
+		{frame: 5, actions: []Action{ActionWindUp, ActionToggleBounce}},
+		{frame: 8, pad: InputIntent{PowerDelta: 2}},
+		{frame: 10, actions: []Action{ActionLaunch}},
+		{frame: 30, actions: []Action{ActionAimDown}},
+		{frame: 200, actions: []Action{ActionLaunch}},
+		{frame: 210, angle: 35},
+		{frame: 215, actions: []Action{ActionLaunch}},
+	}
+	const frames = 300
+
+	g := newTestGame()
+	g.macro.startRecording()
+	next := 0
+	for f := 0; f < frames; f++ {
+		var actions []Action
+		var pad InputIntent
+		if next < len(script) && script[next].frame == f {
+			in := script[next]
+			if in.angle != 0 {
+				g.aimAngle = in.angle
+			}
+			actions, pad = in.actions, in.pad
+			next++
+		}
+		// A slow, uneven wall clock must not change the outcome
+		g.advance(0.05*float64(f%3), actions, pad)
+	}
+	want := g.StateHash()
+
+	path := filepath.Join(t.TempDir(), "macro.json")
+	if err := SaveMacro(path, g.macro.macro); err != nil {
+		t.Fatalf("SaveMacro: %v", err)
+	}
+	m, err := LoadMacro(path)
+	if err != nil {
+		t.Fatalf("LoadMacro: %v", err)
+	}
+	if !reflect.DeepEqual(m, g.macro.macro) {
+		t.Fatalf("macro changed on the way through JSON:\n got %+v\nwant %+v", m, g.macro.macro)
+	}
+
+	for _, elapsed := range []float64{0, 1.0 / 144.0, 0.2} {
+		p := newTestGame()
+		p.macro.startPlayback(m)
+		for f := 0; f < m.Length; f++ {
+			p.advance(elapsed, nil, InputIntent{})
+		}
+		if got := p.StateHash(); got != want {
+			t.Errorf("playback at %v s per frame ended in state %#x, recording ended in %#x", elapsed, got, want)
+		}
+		if p.macro.state != macroIdle {
+			t.Errorf("playback at %v s per frame still running after %d frames", elapsed, m.Length)
+		}
+	}
+}
@@ -1,23 +1,33 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"image"
 	"image/color"
 	"log"
 	"math"
+	"math/rand"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
-	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
 const (
 	screenWidth  = 1200
 	screenHeight = 800
 	groundHeight = 100
+
+	minPower = 5.0
+	maxPower = 50.0
+
+	minAimAngle = -45.0 // degrees, below horizontal for downhill shots from the platform
+	maxAimAngle = 90.0
 )
 
+var skyColor = color.RGBA{135, 206, 235, 255}
+
 type Vector2 struct {
 	X, Y float64
 }
@@ -35,324 +45,1217 @@ func (v Vector2) Magnitude() float64 {
 }
 
 type Ball struct {
-	Position     Vector2
-	Velocity     Vector2
-	InitialPos   Vector2
-	InitialVel   Vector2
-	Time         float64
-	Launched     bool
-	Trail        []Vector2
-	MaxTrailLen  int
-	Color        color.RGBA
+	Position      Vector2
+	Velocity      Vector2
+	Params        LaunchParams
+	Time          float64
+	Launched      bool
+	Landed        bool
+	Rolling       bool
+	Staged        bool
+	Returning     bool
+	ReturnFrom    Vector2
+	ReturnHome    Vector2
+	ReturnTime    float64
+	Bounces       int
+	Radius        float64
+	Trail         []TrailPoint
+	TrimMode      TrailTrimMode
+	MaxTrailLen   int
+	MaxTrailAge   float64
+	MaxTrailDist  float64
+	TrailInterval float64 // seconds of flight between trail samples
+	Color         color.RGBA
+}
+
+type Target struct {
+	Position        Vector2
+	HP              int
+	MaxHP           int
+	ClosestApproach float64 // nearest the ball's edge came during the current shot, in pixels
+	ClosestPoint    Vector2 // where on the ball's path that was
+	DescendingOnly  bool    // only counts hits from a falling ball
+	Velocity        Vector2 // pixels per second, zero for fixed targets
+	Kind            TargetKind
+	MinSpeed        float64 // m/s, with MaxSpeed the impact speeds that count, both 0 for any speed
+	MaxSpeed        float64
+	Radius          float64 // drawn radius in pixels
+}
+
+func NewTarget(x, y float64, hp int) Target {
+	return Target{Position: Vector2{x, y}, HP: hp, MaxHP: hp, ClosestApproach: math.Inf(1), Radius: targetRadius}
+}
+
+// acceptsHit reports whether a ball moving with vel can score on the target
+func (t Target) acceptsHit(vel Vector2) bool {
+	return !t.DescendingOnly || vel.Y < 0
+}
+
+// Color darkens the target's base color as it takes damage
+func (t Target) Color() color.RGBA {
+	health := float64(t.HP) / float64(t.MaxHP)
+	c := t.Kind.BaseColor()
+	k := 0.47 + 0.53*health
+	return color.RGBA{uint8(float64(c.R) * k), uint8(float64(c.G) * k), uint8(float64(c.B) * k), 255}
 }
 
 type Game struct {
 	ball          Ball
 	cannon        Vector2
+	launchHeight  float64
 	aimAngle      float64
 	aimPower      float64
+	aimAssist     float64
+	snapAim       bool // snap the aim onto common angles
+	projectile    int
+	spin          float64
+	coriolis      bool
 	showTrail     bool
 	showVectors   bool
+	showFan       bool
+	showAxes      bool
+	pinnedPreview []Vector2
+	components    bool
+	bounce        bool
+	surfaces      Surfaces
+	friction      float64
+	maxFlightTime float64 // seconds before a shot still in the air is called a miss and reset, 0 for never
+	wind          Wind
+	rocket        *Rocket
+	twoStage      bool
+	time          float64
+	bounceLimit   int
+	dodgeMode     bool
+	shotSamples   []ShotSample
+	lastShot      []ShotSample
+	lastParams    LaunchParams // the launch lastShot was flown with
+	previousTrail []Vector2    // path of the last finished shot, drawn as a ghost
+	chargeMode    bool         // hold Space to build power, release to launch
+	charging      bool
+	chargeHeld    float64 // seconds Space has been held while charging
+	editing       bool    // target editor is open, physics is held
+	layoutEdited  bool    // a target was placed or removed since the editor opened
+	traceMode     bool
+	spreadCount   int          // balls per launch
+	spreadAngle   float64      // degrees across the whole spread
+	pellets       []Ball       // the balls of a spread shot besides g.ball
+	trace         []Vector2    // dots stamped along every shot in trace mode
+	lastTrail     []TrailPoint // trail of the last finished shot, kept on show until the next launch
+	shotLog       []ShotLogEntry
+	sessionShots  []ShotLogEntry // every shot this game, for the session summary
+	shotLogScroll int            // entries scrolled back from the newest
+	impactVel     Vector2
+	hasImpact     bool
+	nearMissPoint Vector2 // closest approach of the last near miss
+	nearMissTimer float64
+	windsockPhase float64 // radians, drives the windsock's ripple
+	replaying     bool
+	replayTime    float64
+	replayOverlay bool
+	scrubbing     bool
+	scrubIndex    int
+	message       string
+	messageTimer  float64
+	ticks         int
+	clock         FixedStep
+	prevBallPos   Vector2
+	macro         MacroRecorder
+	gamepadIDs    []ebiten.GamepadID
+	dragSlider    int // index of the slider being dragged, -1 for none
+	showDiag      bool
+	showHelp      bool
+	showRangePlot bool
+	compareDrag   bool // overlay the vacuum and drag paths of the current aim
+	showCollision bool
+	measure       []Vector2
+	protractor    []Vector2 // vertex, then a point on each arm
+	config        Config
+	bindings      []keyBinding // keyBindings with the config's keymap applied
+	directKeys    []keyBinding // directKeyBindings with the config's keymap applied
+	presets       map[string]ShotPreset
+	hudImage      *ebiten.Image
+	entry         ValueEntry
+	toasts        ToastQueue
+	particles     *ParticleSystem
+	explosions    []Particle // sparks from destroyed targets
+	integrator    int        // index into integrators
+	sessionStart  time.Time
+	watchStart    time.Time // stopwatch start at the first launch, zero until then
+	watchStop     time.Time // when the targets were cleared, zero while running
+	simTime       float64   // seconds simulated this session, scaled by timeScale
+	seed          int64     // seeds every random source, so a session can be reproduced
+	rng           *rand.Rand
 	paused        bool
+	resetPending  time.Time // when R was first pressed, zero unless a reset awaits confirmation
+	freezeFrame   bool      // paused by the freeze frame key, showing the ball's full state
 	gravity       float64
 	scale         float64
 	timeScale     float64
-	targets       []Vector2
+	targets       []Target
+	activeTarget  int
+	level         int
+	obstacles     []Obstacle
+	walls         Walls
+	terrain       Terrain
 	score         int
+	twoPlayer     bool
+	currentPlayer int // whose turn it is in two-player mode
+	shooter       int // who fired the shot in flight, credited with its hits
+	playerScores  [numPlayers]int
+	combo         int     // targets destroyed in a row, each within comboWindow of the last
+	lastHitTime   float64 // game time of the last destroyed target
 	attempts      int
+	levelStart    int // attempts when the current level began
+	fewestShots   int // fewest shots to clear a level this game, 0 before the first clear
+	highScore     HighScore
+	camera        Camera
+	cinematic     bool
+	antialias     bool
+	follow        bool
+	photoMode     bool
+	photoPending  bool
+	screenshot    bool // save the next frame to a PNG
+	gifArmed      bool
+	gifFrames     []*image.RGBA
+	gifImage      *ebiten.Image
+	sceneImage    *ebiten.Image
 }
 
 // Consts
 var (
-	defaultGravity   = 9.8   // m/s²
-	defaultScale     = 50.0  // pixels per meter
-	defaultTimeScale = 1.0   // time multiplier
+	defaultGravity   = 9.8  // m/s²
+	defaultScale     = 50.0 // pixels per meter
+	defaultTimeScale = 1.0  // time multiplier
+
+	defaultRestitution = 0.6 // fraction of speed into a surface kept per bounce
+	minBounceSpeed     = 1.0 // m/s, slower impacts end the shot
+	defaultFriction    = 0.3 // rolling friction coefficient
+
+	defaultMaxFlightTime = 15.0 // seconds
+
+	defaultSeed int64 = 1 // seed for target placement, gusts and effects
 )
 
-func NewGame() *Game {
+// fanOffsets are the angles, relative to the aim, of the arcs in the trajectory fan
+var fanOffsets = []float64{-20, -10, 10, 20}
+
+// bounceLimits are the selectable bounce limits, 0 meaning unlimited
+var bounceLimits = []int{0, 1, 2, 3, 5}
+
+// NewGame lays out a game from the seed and the saved config, presets and records
+func NewGame(seed int64) *Game {
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		log.Printf("using default config: %v", err)
+	}
+	game := newGameWithConfig(seed, cfg)
+
+	presets, err := LoadPresets(presetsPath)
+	if err != nil {
+		log.Printf("ignoring presets: %v", err)
+	}
+	game.presets = presets
+
+	hs, err := LoadHighScore(highScorePath)
+	if err != nil {
+		log.Printf("ignoring high score: %v", err)
+	}
+	game.highScore = hs
+
+	return game
+}
+
+// newGameWithConfig lays out a game from the seed and cfg alone, touching no files
+func newGameWithConfig(seed int64, cfg Config) *Game {
 	game := &Game{
-		cannon:      Vector2{100, float64(screenHeight - groundHeight)},
 		aimAngle:    45.0,
-		aimPower:    20.0,
+		aimPower:    12.0,
 		showTrail:   true,
 		showVectors: true,
+		antialias:   true,
+		spreadCount: spreadCounts[0],
+		spreadAngle: spreadAngles[1],
 		gravity:     defaultGravity,
+		friction:    defaultFriction,
 		scale:       defaultScale,
 		timeScale:   defaultTimeScale,
+		camera:      NewCamera(),
+		seed:        seed,
+		rng:         rand.New(rand.NewSource(seed)),
+		wind:        NewWind(seed),
+		particles:   NewParticleSystem(seed),
+		presets:     map[string]ShotPreset{},
+		dragSlider:  -1,
+	}
+	game.sessionStart = time.Now()
+
+	game.config = cfg
+	game.config.HUDScale = clampHUDScale(cfg.HUDScale)
+	game.surfaces = cfg.Restitution
+	game.maxFlightTime = cfg.MaxFlightTime
+	game.terrain = terrainFromMeters(cfg.Terrain, game.scale)
+	game.cannon = cannonPosition(0, game.scale, game.terrain)
+	// One keymap covers both lists, so they are remapped together and split again
+	all := append(append([]keyBinding(nil), keyBindings...), directKeyBindings...)
+	bindings, errs := remapKeys(all, cfg.Keymap)
+	for _, err := range errs {
+		log.Printf("ignoring %v", err)
 	}
-	
+	game.bindings, game.directKeys = bindings[:len(keyBindings)], bindings[len(keyBindings):]
+
 	game.ball = Ball{
-		Position:    game.cannon,
-		MaxTrailLen: 200,
-		Color:       color.RGBA{255, 100, 100, 255},
+		Position:      game.cannon,
+		MaxTrailLen:   600,
+		MaxTrailAge:   2.0,
+		MaxTrailDist:  600,
+		TrailInterval: 1.0 / 60.0,
+		Radius:        projectiles[0].Radius,
+		Color:         projectiles[0].Color,
 	}
-	
+
 	// Targets
-	game.targets = []Vector2{
-		{800, float64(screenHeight - groundHeight - 50)},
-		{600, float64(screenHeight - groundHeight - 100)},
-		{1000, float64(screenHeight - groundHeight - 30)},
+	game.level = 1
+	game.targets = GenerateTargets(game.level, game.rng)
+	if len(cfg.CustomTargets) > 0 {
+		game.targets = customTargets(cfg.CustomTargets)
+	}
+
+	// Obstacles
+	game.obstacles = []Obstacle{
+		{Min: Vector2{450, float64(screenHeight - groundHeight - 120)}, Max: Vector2{470, float64(screenHeight - groundHeight)}},
 	}
-	
+
 	return game
 }
 
 func (b *Ball) Update(dt float64) {
-	if !b.Launched {
+	if b.Returning {
+		b.updateReturn(dt)
+	}
+	if !b.Launched || b.Landed {
 		return
 	}
-	
-	b.Time += dt
-	
+
 	// Physics projectile motion equations
-	b.Position.X = b.InitialPos.X + b.InitialVel.X*b.Time
-	b.Position.Y = b.InitialPos.Y - (b.InitialVel.Y*b.Time - 0.5*9.8*b.Time*b.Time)
-	
-	// Add to trail
-	if len(b.Trail) > 0 {
-		lastPos := b.Trail[len(b.Trail)-1]
-		distance := math.Sqrt((b.Position.X-lastPos.X)*(b.Position.X-lastPos.X) + 
-							 (b.Position.Y-lastPos.Y)*(b.Position.Y-lastPos.Y))
-		if distance > 5 {
-			b.Trail = append(b.Trail, b.Position)
-		}
-	} else {
-		b.Trail = append(b.Trail, b.Position)
-	}
-	
+	prevPos, prevSpeed, prevT := b.Position, b.Velocity.Magnitude(), b.Time
+	b.Position, b.Velocity = b.Params.Step(b.Position, b.Velocity, b.Time, dt)
+	b.Time += dt
+
+	// Add to trail on a fixed cadence of flight time
+	b.sampleTrail(prevPos, prevSpeed, prevT)
+
 	// Limit trail length
-	if len(b.Trail) > b.MaxTrailLen {
-		b.Trail = b.Trail[1:]
-	}
+	b.trimTrail()
 }
 
-func (b *Ball) Launch(angle, power float64, startPos Vector2) {
+func (b *Ball) Launch(params LaunchParams) {
 	b.Launched = true
+	b.Staged = false
+	b.Returning = false
 	b.Time = 0
-	b.InitialPos = startPos
-	b.Position = startPos
-	b.Trail = []Vector2{startPos}
-	
-	// Convert to rads
-	angleRad := angle * math.Pi / 180.0
-	b.InitialVel = Vector2{
-		X: power * math.Cos(angleRad),
-		Y: power * math.Sin(angleRad),
+	b.Bounces = 0
+	b.Rolling = false
+	b.Params = params
+	b.Radius = params.Projectile.Radius
+	b.Color = params.Projectile.Color
+	b.Position = params.Start
+	b.Trail = []TrailPoint{{params.Start, 0, params.Power}}
+	b.Velocity = params.InitialVelocity()
+}
+
+// Bounce reverses the vertical velocity, scaled by the restitution coefficient
+func (b *Ball) Bounce(restitution float64) {
+	b.Velocity.Y = -b.Velocity.Y * restitution
+	b.Bounces++
+}
+
+// Roll moves the ball along the ground, slowing it by decel m/s² until it stops
+func (b *Ball) Roll(dt, decel float64) {
+	prevPos, prevSpeed, prevT := b.Position, b.Velocity.Magnitude(), b.Time
+	b.Time += dt
+
+	v := b.Velocity.X
+	newV := v - math.Copysign(decel*dt, v)
+	if newV*v <= 0 {
+		// Friction stops the ball, it never reverses it
+		newV = 0
+		dt = math.Abs(v) / decel
 	}
-	b.Velocity = b.InitialVel
+	b.Position.X += (v + newV) / 2 * dt * b.Params.Scale
+	b.Velocity = Vector2{newV, 0}
+
+	b.sampleTrail(prevPos, prevSpeed, prevT)
+	b.trimTrail()
 }
 
 func (b *Ball) Reset() {
 	b.Launched = false
+	b.Landed = false
+	b.Rolling = false
 	b.Time = 0
-	b.Trail = []Vector2{}
+	b.Bounces = 0
+	b.Trail = []TrailPoint{}
 }
 
-func (b *Ball) IsGrounded() bool {
-	return b.Position.Y >= float64(screenHeight-groundHeight-10)
+// IsGrounded reports whether the ball has reached the terrain
+func (b *Ball) IsGrounded(terrain Terrain) bool {
+	return terrain.Touches(b.Position, b.Velocity, b.Radius)
 }
 
-func (g *Game) Update() error {
-	if !g.paused {
-		if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-			if !g.ball.Launched {
-				g.ball.Launch(g.aimAngle, g.aimPower, g.cannon)
-				g.attempts++
-			} else {
-				g.ball.Reset()
-				g.ball.Position = g.cannon
+// launchParams collects the current aim and physics settings for a launch from the cannon
+func (g *Game) launchParams() LaunchParams {
+	wind, launchTime := g.wind, g.time
+	return LaunchParams{
+		Angle:      g.launchAngle(),
+		Power:      g.aimPower,
+		Gravity:    g.gravity,
+		Start:      g.cannon,
+		Scale:      g.scale,
+		Wind:       func(t float64) float64 { return wind.WindAt(launchTime + t) },
+		Rocket:     g.rocket,
+		Projectile: g.currentProjectile(),
+		AirDensity: g.config.AirDensity,
+		Spin:       g.spin,
+		Coriolis:   g.coriolisRate(),
+		Integrator: integrators[g.integrator],
+	}
+}
+
+func (g *Game) launch() {
+	g.ball.Launch(g.launchParams())
+	g.attempts++
+	g.shooter = g.currentPlayer
+	g.startStopwatch(time.Now())
+	g.replaying = false
+
+	// Muzzle smoke
+	angleRad := g.ball.Params.Angle * math.Pi / 180.0
+	g.particles.Spawn(g.cannon, 20, angleRad, 0.4, 120, 0.8, color.RGBA{200, 200, 200, 180})
+	g.shotSamples = []ShotSample{g.ball.sample()}
+	g.lastTrail = nil
+	g.launchPellets()
+
+	for i := range g.targets {
+		g.targets[i].ClosestApproach = math.Inf(1)
+	}
+}
+
+// endShot stops the ball where it is and resolves anything that depends on the whole flight
+func (g *Game) endShot(hitTarget bool) {
+	g.ball.Landed = true
+	g.shotSamples = append(g.shotSamples, g.ball.sample())
+	g.lastShot = g.shotSamples
+	g.lastParams = g.ball.Params
+	g.previousTrail = shotPath(g.shotSamples, ghostSampleStride)
+	g.lastTrail = append([]TrailPoint(nil), g.ball.Trail...)
+	g.logShot(hitTarget)
+
+	near := false
+	for _, t := range g.targets {
+		near = near || t.ClosestApproach < nearMissFactor*t.HitRadius()
+	}
+	g.toasts.Push(classifyShot(hitTarget, near, g.ball.Position).Toast())
+	if !hitTarget {
+		g.markNearMiss()
+	}
+
+	if g.dodgeMode {
+		g.dodgeNearMisses()
+	}
+	g.saveHighScore()
+	g.endTurn()
+}
+
+// step advances the simulation by dt seconds of game time, independent of any input
+func (g *Game) step(dt float64) {
+	g.prevBallPos = g.ball.Position
+	g.time += dt
+	g.moveTargets(dt)
+	g.particles.Update(dt, g.gravity*g.scale)
+	g.explosions = updateParticles(g.explosions, dt)
+	g.expireCombo()
+	g.stepPellets(dt)
+
+	if !g.ball.Launched {
+		g.ball.Update(dt)
+	}
+
+	if g.ball.Launched && !g.ball.Landed {
+		// A shot that never comes down, say under very low gravity, is given up on
+		if g.maxFlightTime > 0 && g.ball.Time >= g.maxFlightTime {
+			g.endShot(false)
+			g.flash("Flight time limit reached")
+			g.ResetBall()
+			return
+		}
+
+		prev := g.ball.Position
+		if g.ball.Rolling {
+			g.ball.Roll(dt, g.friction*g.gravity)
+			// Rolling follows the terrain's surface, slopes don't speed it up or slow it down
+			g.ball.Position.Y = g.terrain.ContactY(g.ball.Position.X, g.ball.Radius)
+			if g.ball.Velocity.X == 0 {
+				g.endShot(false)
+			}
+		} else {
+			g.ball.Update(dt)
+			if g.twoStage && g.ball.Time >= stageTime {
+				g.stage()
 			}
+			g.ball.Position, g.ball.Velocity, _ = reflectOffWalls(g.ball.Position, g.ball.Velocity, g.walls, g.surfaces.Walls, g.ball.Radius)
 		}
-		
-		if ebiten.IsKeyPressed(ebiten.KeyArrowUp) && g.aimAngle < 90 {
-			g.aimAngle += 1
+		g.shotSamples = append(g.shotSamples, g.ball.sample())
+		if g.traceMode && len(g.shotSamples)%traceStride == 0 {
+			g.trace = stampTrace(g.trace, g.ball.Position, maxTracePoints)
 		}
-		if ebiten.IsKeyPressed(ebiten.KeyArrowDown) && g.aimAngle > 0 {
-			g.aimAngle -= 1
+
+		for i := range g.targets {
+			p, d := closestApproachPoint([]Vector2{prev, g.ball.Position}, g.targets[i].Position)
+			if d -= g.ball.Radius; d < g.targets[i].ClosestApproach {
+				g.targets[i].ClosestApproach = d
+				g.targets[i].ClosestPoint = p
+			}
 		}
-		if ebiten.IsKeyPressed(ebiten.KeyArrowRight) && g.aimPower < 50 {
-			g.aimPower += 0.5
+
+		// Check if ball hit a target or an obstacle on the way
+		hit := firstHitAlong(prev, g.ball.Position, g.ball.Velocity, g.ball.Radius, g.targets, g.obstacles)
+		if hit.Kind == HitObstacle && g.bounce {
+			vel, onTop := reflectOffObstacle(g.ball.Velocity, prev, g.obstacles[hit.Index].Expand(g.ball.Radius), g.surfaces.Obstacles)
+			if !onTop || math.Abs(vel.Y) >= minBounceSpeed {
+				// Back out to where the ball was before it touched the block
+				g.ball.Position = prev
+				g.ball.Velocity = vel
+				return
+			}
 		}
-		if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) && g.aimPower > 5 {
-			g.aimPower -= 0.5
+		if hit.Kind == HitTarget {
+			if t := g.targets[hit.Index]; !t.SpeedFits(g.ball.Velocity.Magnitude()) {
+				// Outside the target's speed window the ball glances off harmlessly
+				g.flash(speedWindowMiss(t, g.ball.Velocity.Magnitude()))
+				g.ball.Velocity = deflectOff(g.ball.Velocity, hit.Point, t.Position, defaultRestitution)
+				g.ball.Position = prev
+				return
+			}
 		}
-		
-		// Update ball
-		if g.ball.Launched {
-			g.ball.Update(1.0/60.0 * g.timeScale)
-			
-			// Check if ball hit ground
-			if g.ball.IsGrounded() {
-				// Check if hit any targets
-				for i, target := range g.targets {
-					distance := math.Sqrt((g.ball.Position.X-target.X)*(g.ball.Position.X-target.X) + 
-										 (g.ball.Position.Y-target.Y)*(g.ball.Position.Y-target.Y))
-					if distance < 30 {
-						g.score++
-						// Remove hit target
-						g.targets = append(g.targets[:i], g.targets[i+1:]...)
-						break
-					}
-				}
+		if hit.Kind != HitNone {
+			g.ball.Position = hit.Point
+			if hit.Kind == HitTarget {
+				// Debris
+				g.particles.Spawn(hit.Point, 30, math.Pi/2, math.Pi, 250, 1.0, g.targets[hit.Index].Color())
+				g.hitTarget(hit.Index)
 			}
+			g.endShot(hit.Kind == HitTarget)
+			return
+		}
+
+		// A shot that comes straight back down onto the top of the cannon
+		if g.ball.Velocity.Y < 0 && g.ball.Position.Y < g.cannon.Y && circlesOverlap(g.ball.Position, g.ball.Radius, g.cannon, cannonRadius) {
+			g.endShot(false)
+			g.flash("Self-hit! The cannon is not a target")
+			g.ResetBall()
+			return
+		}
+
+		// Check if ball hit ground while coming down
+		if !g.ball.Rolling && g.ball.IsGrounded(g.terrain) {
+			g.ball.Position = g.terrain.Crossing(prev, g.ball.Position, g.ball.Radius)
+
+			// The first touchdown is the one reported as the impact
+			if g.ball.Bounces == 0 {
+				g.impactVel = g.ball.Velocity
+				g.hasImpact = true
+			}
+
+			// Dirt kicked up by the impact
+			g.particles.Spawn(g.ball.Position, 15, math.Pi/2, 0.8, 150, 0.6, color.RGBA{110, 80, 40, 220})
+
+			if g.canBounce() {
+				g.ball.Bounce(g.surfaces.Ground)
+			} else if g.bounce && math.Abs(g.ball.Velocity.X) > 0 {
+				// Out of bounce, the ball rolls to a stop
+				g.ball.Rolling = true
+				g.ball.Velocity.Y = 0
+			} else {
+				g.endShot(false)
+			}
+		}
+	}
+}
+
+// canBounce reports whether the ball rebounds from its current ground contact
+// instead of ending the shot
+func (g *Game) canBounce() bool {
+	if !g.bounce || math.Abs(g.ball.Velocity.Y)*g.surfaces.Ground < minBounceSpeed {
+		return false
+	}
+	return g.bounceLimit == 0 || g.ball.Bounces < g.bounceLimit
+}
+
+// hitTarget takes one hitpoint off target i, removing it and scoring once it
+// is destroyed, and spawning its pieces if it was a splitter
+func (g *Game) hitTarget(i int) {
+	g.targets[i].HP--
+	if g.targets[i].HP <= 0 {
+		t := g.targets[i]
+		g.explode(t.Position)
+		g.scoreTarget(t)
+		g.targets = append(g.targets[:i], g.targets[i+1:]...)
+
+		// Splitters break in two, as long as the screen is not already crowded
+		if t.Kind == TargetSplitter && len(g.targets)+2 <= maxSplitTarget {
+			g.targets = append(g.targets, splitTarget(t)...)
+		}
+
+		// Keep the selection on the same target, or a valid one if it was destroyed
+		if i < g.activeTarget {
+			g.activeTarget--
+		}
+		g.activeTarget = clampTargetIndex(g.activeTarget, len(g.targets))
+
+		if scoringTargetsLeft(g.targets) == 0 {
+			g.stopStopwatch(time.Now())
+			g.nextLevel()
+		}
+	}
+}
+
+// clampTargetIndex keeps a target index inside [0, count), returning 0 when there are no targets
+func clampTargetIndex(index, count int) int {
+	if index >= count {
+		index = count - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	return index
+}
+
+// nextBounceLimit cycles through the selectable bounce limits
+func nextBounceLimit(limit int) int {
+	for i, l := range bounceLimits {
+		if l == limit {
+			return bounceLimits[(i+1)%len(bounceLimits)]
 		}
 	}
-	
-	if inpututil.IsKeyJustPressed(ebiten.KeyT) {
-		g.showTrail = !g.showTrail
+	return bounceLimits[0]
+}
+
+// ResetBall sends the ball back to the cannon and clears its trail, leaving
+// score, attempts, targets and settings as they are
+func (g *Game) ResetBall() {
+	g.pellets = nil
+	g.ball.Reset()
+	g.ball.ReturnTo(g.cannon)
+}
+
+// reset starts a new game, first saving any records the old one beat. The
+// macro recorder and the session clocks survive so a recording or playback
+// can span resets, the player count carries over, and the same seed lays
+// the new game out the same way.
+func (g *Game) reset() {
+	g.saveHighScore()
+
+	macro, start, simTime, twoPlayer := g.macro, g.sessionStart, g.simTime, g.twoPlayer
+	*g = *NewGame(g.seed)
+	g.macro, g.sessionStart, g.simTime, g.twoPlayer = macro, start, simTime, twoPlayer
+}
+
+func (g *Game) Update() error {
+	g.ticks++
+	elapsed := g.clock.Tick(time.Now())
+
+	// Closing the window saves the records and exports the session summary before quitting
+	if ebiten.IsWindowBeingClosed() {
+		g.saveHighScore()
+		g.exportSession()
+		return ebiten.Termination
+	}
+
+	if g.justPressed(ActionScreenshot) {
+		g.screenshot = true
+	}
+	if g.justPressed(ActionPhotoMode) {
+		g.photoMode = !g.photoMode
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyV) {
-		g.showVectors = !g.showVectors
+	if g.photoMode {
+		g.updatePhotoMode()
+		return nil
+	}
+
+	if g.justPressed(ActionDiagnostics) {
+		g.showDiag = !g.showDiag
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
-		g.paused = !g.paused
+	if g.justPressed(ActionMeterAxes) {
+		g.showAxes = !g.showAxes
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
-		*g = *NewGame() // Reset game
+	if g.justPressed(ActionHUDSmaller) {
+		g.changeHUDScale(-1)
 	}
-	
+	if g.justPressed(ActionHUDBigger) {
+		g.changeHUDScale(1)
+	}
+	if g.justPressed(ActionRecordMacro) {
+		g.toggleMacroRecording()
+	}
+	if g.justPressed(ActionPlayMacro) {
+		g.playMacro()
+	}
+	if g.justPressed(ActionRecordGIF) {
+		g.toggleGIFRecording()
+	}
+
+	// Typed entry takes over the keyboard until it is confirmed or cancelled
+	var actions []Action
+	var pad InputIntent
+	if g.entry.Active {
+		g.updateEntry()
+	} else {
+		if g.justPressed(ActionTypeEntry) && !g.ball.Launched && !g.paused {
+			g.openEntry()
+		}
+		if !g.paused {
+			g.updateMeasure()
+			g.updatePresets()
+			g.updateShotLog()
+			if g.editing {
+				g.updateEditor()
+			} else if !g.updateSliders() && !g.updateMinimap() {
+				g.updateReticle()
+			}
+		}
+		actions = pollActions(g.bindings)
+		if g.chargeMode && !g.paused && g.updateCharge(1.0/60.0) {
+			actions = append(actions, ActionChargeRelease)
+		}
+		pad = g.gamepadIntent()
+	}
+
+	g.advance(elapsed, actions, pad)
+
+	if g.messageTimer > 0 {
+		g.messageTimer -= 1.0 / 60.0
+	}
+	if g.nearMissTimer > 0 {
+		g.nearMissTimer -= 1.0 / 60.0
+	}
+	g.toasts.Update(1.0 / 60.0)
+
+	g.updateScrub()
+
+	if g.paused && !g.freezeFrame {
+		return g.updatePauseMenu()
+	}
+
 	return nil
 }
 
+// advance applies a frame's input, through the macro recorder, and runs the
+// simulation on by elapsed seconds of real time
+func (g *Game) advance(elapsed float64, actions []Action, pad InputIntent) {
+	// Macros record and replay input from every source, and the simulation
+	// advances a fixed 1/60 s per frame meanwhile so playback ends in the
+	// recorded state
+	if g.macro.deterministic() {
+		elapsed = 1.0 / 60.0
+	}
+	frame := g.macro.process(MacroFrame{Settings: g.macroSettings(), Actions: actions, Pad: pad})
+	g.applyMacroSettings(frame.Settings)
+	for _, a := range frame.Actions {
+		g.applyAction(a)
+	}
+	g.applyIntent(mergeIntents(actionIntent(frame.Actions), frame.Pad))
+
+	if g.paused || g.editing {
+		return
+	}
+
+	// Physics runs in fixed substeps however long the frame took. After a
+	// stall the clocks skip ahead no further than the physics does.
+	dt := math.Min(elapsed*g.timeScale, maxFrameTime)
+	for n := g.clock.Advance(dt); n > 0; n-- {
+		g.step(physicsDt)
+	}
+	g.simTime += dt
+	g.updateReplay(dt)
+	g.updateWindsock(dt)
+
+	if g.follow {
+		g.updateCameraFollow(1.0 / 60.0)
+	}
+}
+
 func (g *Game) Draw(screen *ebiten.Image) {
+	g.drawFrame(screen)
+	g.captureGIFFrame(screen)
+
+	if g.showDiag {
+		g.drawDiagnostics(screen)
+	}
+	if g.entry.Active {
+		g.drawEntry(screen)
+	}
+	if g.showHelp && !g.photoMode {
+		g.drawHelp(screen)
+	}
+	if g.paused && !g.photoMode && !g.freezeFrame {
+		g.drawPauseMenu(screen)
+	}
+	if g.editing && !g.photoMode {
+		g.drawEditorBanner(screen)
+	}
+	if g.photoMode {
+		g.drawPhotoOverlay(screen)
+	}
+	if g.screenshot {
+		g.screenshot = false
+		g.saveScreenshot(screen)
+	}
+}
+
+// drawFrame renders the scene through the camera, then the UI unless cinematic mode hides it
+func (g *Game) drawFrame(dst *ebiten.Image) {
+	if g.sceneImage == nil {
+		g.sceneImage = ebiten.NewImage(screenWidth, screenHeight)
+	}
+	g.drawScene(g.sceneImage)
+
+	dst.Fill(skyColor)
+	dst.DrawImage(g.sceneImage, g.camera.DrawOptions())
+
+	if !g.cinematic {
+		if g.showMinimap() {
+			g.drawMinimap(dst)
+		}
+		g.drawWindsock(dst)
+		g.drawHUD(dst)
+	}
+}
+
+func (g *Game) drawScene(screen *ebiten.Image) {
 	// Clear screen
-	screen.Fill(color.RGBA{135, 206, 235, 255}) // Sky blue
-	
+	screen.Fill(skyColor)
+
 	// Draw ground
-	vector.DrawFilledRect(screen, 0, float32(screenHeight-groundHeight), 
-						 screenWidth, groundHeight, color.RGBA{34, 139, 34, 255}, false)
-	
+	g.drawTerrain(screen)
+
+	g.drawWalls(screen)
+
+	if g.showAxes {
+		g.drawAxes(screen)
+	}
+
+	// Draw obstacles
+	for _, o := range g.obstacles {
+		fillRect(screen, float32(o.Min.X), float32(o.Min.Y), float32(o.Max.X-o.Min.X), float32(o.Max.Y-o.Min.Y),
+			color.RGBA{120, 80, 40, 255}, g.antialias)
+	}
+
+	g.drawPlatform(screen)
+
 	// Draw cannon
-	cannonSize := float32(20)
-	vector.DrawFilledCircle(screen, float32(g.cannon.X), float32(g.cannon.Y), 
-						   cannonSize, color.RGBA{64, 64, 64, 255}, false)
-	
-	// Draw aim line
+	fillCircle(screen, float32(g.cannon.X), float32(g.cannon.Y),
+		cannonRadius, g.cannonColor(), g.antialias)
+
+	// Draw aim line, curving with wind and drag
 	if !g.ball.Launched {
-		angleRad := g.aimAngle * math.Pi / 180.0
-		aimLength := g.aimPower * 3
-		endX := g.cannon.X + math.Cos(angleRad)*aimLength
-		endY := g.cannon.Y - math.Sin(angleRad)*aimLength
-		
-		vector.StrokeLine(screen, float32(g.cannon.X), float32(g.cannon.Y),
-						 float32(endX), float32(endY), 3, color.RGBA{255, 255, 0, 255}, false)
-	}
-	
+		g.drawAimLine(screen)
+		g.drawAimGauge(screen)
+		g.drawChargeLabel(screen)
+	}
+
+	// Draw the pinned reference arc under the live preview
+	for i := 1; i < len(g.pinnedPreview); i++ {
+		a, b := g.pinnedPreview[i-1], g.pinnedPreview[i]
+		strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 2,
+			color.RGBA{0, 220, 255, 160}, g.antialias)
+	}
+
+	// Draw faint arcs for neighbouring angles
+	if !g.ball.Launched && g.showFan {
+		for _, offset := range fanOffsets {
+			g.drawPrediction(screen, g.launchAngle()+offset, color.RGBA{255, 255, 255, 50}, false)
+		}
+	}
+
 	// Draw predicted trajectory
 	if !g.ball.Launched && g.showVectors {
-		angleRad := g.aimAngle * math.Pi / 180.0
-		vx := g.aimPower * math.Cos(angleRad)
-		vy := g.aimPower * math.Sin(angleRad)
-		
-		for t := 0.0; t < 10.0; t += 0.1 {
-			x := g.cannon.X + vx*t
-			y := g.cannon.Y - (vy*t - 0.5*g.gravity*t*t)
-			
-			if y >= float64(screenHeight-groundHeight) {
-				break
+		g.drawPrediction(screen, g.launchAngle(), color.RGBA{255, 255, 0, 100}, true)
+	}
+	if !g.ball.Launched && g.compareDrag {
+		g.drawDragComparison(screen)
+	}
+
+	g.drawTrace(screen)
+	g.drawGhost(screen)
+
+	// Draw ball trail colored by speed, thinning and fading out as its
+	// segments age in flight time. Once the ball is back at the cannon the
+	// finished shot's trail stays up, unfaded, until the next launch.
+	trail := g.ball.Trail
+	if !g.ball.Launched {
+		trail = g.lastTrail
+	}
+	if g.showTrail && len(trail) > 1 {
+		minS, maxS := speedRange(trail)
+		for i := 1; i < len(trail); i++ {
+			age := 0.0
+			if g.ball.Launched {
+				age = g.ball.Time - trail[i].T
+			}
+			alpha := trailAlpha(age, trailLifetime)
+			if alpha == 0 {
+				continue
 			}
-			
-			vector.DrawFilledCircle(screen, float32(x), float32(y), 2, 
-								   color.RGBA{255, 255, 0, 100}, false)
+			trailColor := speedColor(trail[i].Speed, minS, maxS)
+			trailColor.A = alpha
+			width := float32(1 + 3*float64(alpha)/255)
+
+			strokeLine(screen, float32(trail[i-1].Pos.X), float32(trail[i-1].Pos.Y),
+				float32(trail[i].Pos.X), float32(trail[i].Pos.Y),
+				width, trailColor, g.antialias)
 		}
 	}
-	
-	// Draw ball trail
-	if g.showTrail && len(g.ball.Trail) > 1 {
-		for i := 1; i < len(g.ball.Trail); i++ {
-			alpha := uint8(float64(i) / float64(len(g.ball.Trail)) * 255)
-			trailColor := color.RGBA{255, 200, 200, alpha}
-			
-			vector.StrokeLine(screen, float32(g.ball.Trail[i-1].X), float32(g.ball.Trail[i-1].Y),
-							 float32(g.ball.Trail[i].X), float32(g.ball.Trail[i].Y), 
-							 2, trailColor, false)
+
+	// Draw rocket exhaust while the engine burns
+	if r := g.ball.Params.Rocket; g.ball.Launched && !g.ball.Landed && r != nil && r.Burning(g.ball.Time) {
+		speed := g.ball.Velocity.Magnitude()
+		if speed > 0 {
+			back := g.ball.Position.Add(Vector2{-g.ball.Velocity.X, g.ball.Velocity.Y}.Scale(18 / speed))
+			strokeLine(screen, float32(g.ball.Position.X), float32(g.ball.Position.Y),
+				float32(back.X), float32(back.Y), 6, color.RGBA{255, 160, 0, 220}, g.antialias)
 		}
 	}
-	
-	// Draw ball
-	ballRadius := float32(8)
-	vector.DrawFilledCircle(screen, float32(g.ball.Position.X), float32(g.ball.Position.Y), 
-						   ballRadius, g.ball.Color, false)
-	
+
+	// Draw ball between the last two physics states
+	ballPos := g.interpolatedBallPos()
+	fillCircle(screen, float32(ballPos.X), float32(ballPos.Y),
+		float32(g.ball.Radius), g.ball.Color, g.antialias)
+
+	g.drawPellets(screen)
+	g.particles.Draw(screen, g.antialias)
+	g.drawExplosions(screen)
+
 	// Draw targets
-	for _, target := range g.targets {
-		vector.DrawFilledCircle(screen, float32(target.X), float32(target.Y), 15, 
-							   color.RGBA{255, 0, 0, 255}, false)
-		vector.DrawFilledCircle(screen, float32(target.X), float32(target.Y), 10, 
-							   color.RGBA{255, 255, 255, 255}, false)
-		vector.DrawFilledCircle(screen, float32(target.X), float32(target.Y), 5, 
-							   color.RGBA{255, 0, 0, 255}, false)
-	}
-	
-	// Draw velocity vector
+	for i, target := range g.targets {
+		tx, ty := float32(target.Position.X), float32(target.Position.Y)
+		r := float32(target.Radius)
+		fillCircle(screen, tx, ty, r, target.Color(), g.antialias)
+		fillCircle(screen, tx, ty, r*2/3,
+			color.RGBA{255, 255, 255, 255}, g.antialias)
+		fillCircle(screen, tx, ty, r/3, target.Color(), g.antialias)
+
+		// Penalty targets are crossed out, splitters are split down the middle, and every target shows what it is worth
+		if target.Kind == TargetPenalty {
+			c := r * 0.73
+			strokeLine(screen, tx-c, ty-c, tx+c, ty+c, 3, target.Color(), g.antialias)
+			strokeLine(screen, tx-c, ty+c, tx+c, ty-c, 3, target.Color(), g.antialias)
+		}
+		if target.Kind == TargetSplitter {
+			strokeLine(screen, tx, ty-r, tx, ty+r, 2, color.RGBA{255, 255, 255, 255}, g.antialias)
+		}
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%+d", target.Points()), int(tx)-10, int(ty+r)+2)
+		labelY := int(ty+r) + 15
+		if target.HasSpeedWindow() {
+			ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.0f-%.0f m/s", target.MinSpeed, target.MaxSpeed), int(tx)-28, labelY)
+			labelY += 13
+		}
+
+		// Distance and bearing from the cannon for planning
+		dist, bearing := relativeToCannon(target.Position, g.cannon, g.scale)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.1fm %+.0f°", dist, bearing), int(tx)-28, labelY)
+
+		// Downward chevron above targets that only take falling hits
+		if target.DescendingOnly {
+			strokeLine(screen, tx-6, ty-r-21, tx, ty-r-15, 2, color.RGBA{255, 255, 255, 255}, g.antialias)
+			strokeLine(screen, tx, ty-r-15, tx+6, ty-r-21, 2, color.RGBA{255, 255, 255, 255}, g.antialias)
+		}
+
+		// Pulsing ring around the selected target
+		if i == g.activeTarget {
+			pulse := 0.5 + 0.5*math.Sin(float64(g.ticks)*0.15)
+			strokeCircle(screen, tx, ty, r+4+float32(pulse*4), 2,
+				color.RGBA{255, 255, 0, uint8(140 + 115*pulse)}, g.antialias)
+		}
+
+		// Hitpoint pips above multi-hit targets
+		if target.MaxHP > 1 {
+			pipX := tx - float32(target.MaxHP*6)/2
+			for hp := 0; hp < target.MaxHP; hp++ {
+				pipColor := color.RGBA{60, 60, 60, 200}
+				if hp < target.HP {
+					pipColor = color.RGBA{0, 220, 0, 255}
+				}
+				fillRect(screen, pipX+float32(hp*6), ty-r-9, 4, 4, pipColor, g.antialias)
+			}
+		}
+	}
+
+	// Draw velocity vector, and the tangent to the path for reading its angle
 	if g.showVectors && g.ball.Launched {
-		scale := 0.1
-		endX := g.ball.Position.X + g.ball.Velocity.X*scale
-		endY := g.ball.Position.Y - g.ball.Velocity.Y*scale
-		
-		vector.StrokeLine(screen, float32(g.ball.Position.X), float32(g.ball.Position.Y),
-						 float32(endX), float32(endY), 2, color.RGBA{0, 255, 0, 255}, false)
+		g.drawVelocityVector(screen, g.ball.Position, g.ball.Velocity)
+		g.drawTangent(screen, g.ball.Position, g.ball.Velocity)
+	}
+
+	if g.ball.Rolling && !g.ball.Landed {
+		g.drawFrictionArrow(screen)
+	}
+
+	if g.replaying {
+		g.drawReplay(screen)
+	}
+	if g.scrubbing {
+		g.drawScrub(screen)
+	}
+
+	g.drawNearMiss(screen)
+	if g.freezeFrame {
+		g.drawFreezeFrame(screen)
+	}
+	if g.showCollision {
+		g.drawCollisionDebug(screen)
+	}
+	g.drawMeasure(screen)
+	g.drawProtractor(screen)
+
+	if !g.cinematic && !g.photoMode {
+		g.drawReticle(screen)
 	}
-	
-	// Draw UI
-	g.drawUI(screen)
 }
 
-func (g *Game) drawUI(screen *ebiten.Image) {
-	// Draw semi-transparent background for UI
-	vector.DrawFilledRect(screen, 10, 10, 300, 200, color.RGBA{0, 0, 0, 128}, false)
-	
-	// Draw text information
-	texts := []string{
-		fmt.Sprintf("Angle: %.1f°", g.aimAngle),
-		fmt.Sprintf("Power: %.1f m/s", g.aimPower),
+// interpolatedBallPos blends the ball's previous and current physics positions by the leftover frame time
+func (g *Game) interpolatedBallPos() Vector2 {
+	if !g.ball.Launched || g.ball.Landed {
+		return g.ball.Position
+	}
+	a := g.clock.Alpha()
+	return g.prevBallPos.Scale(1 - a).Add(g.ball.Position.Scale(a))
+}
+
+// predictedPath traces the path a shot at the given angle would take with the current settings
+func (g *Game) predictedPath(angle float64) ([]Vector2, Hit) {
+	params := g.launchParams()
+	params.Angle = angle
+	return traceTrajectory(params, g.targets, g.obstacles, g.walls, g.terrain, previewDt, 10.0)
+}
+
+// drawPrediction draws the predicted path of a shot at the given angle, optionally marking where it would stop
+func (g *Game) drawPrediction(screen *ebiten.Image, angle float64, dotColor color.RGBA, markStop bool) {
+	points, hit := g.predictedPath(angle)
+
+	// One dot per 0.1 s of flight
+	for i := 0; i < len(points); i += int(math.Round(0.1 / previewDt)) {
+		p := points[i]
+		fillCircle(screen, float32(p.X), float32(p.Y), 2, dotColor, g.antialias)
+	}
+
+	// Mark where the shot would stop
+	if markStop && hit.Kind != HitNone {
+		markColor := color.RGBA{255, 255, 0, 200}
+		if hit.Kind == HitTarget {
+			markColor = color.RGBA{0, 255, 0, 220}
+		} else if hit.Kind == HitObstacle || hit.Kind == HitWall {
+			markColor = color.RGBA{255, 80, 0, 220}
+		}
+		strokeCircle(screen, float32(hit.Point.X), float32(hit.Point.Y), 8, 2, markColor, g.antialias)
+		g.drawLandingMarker(screen, points, previewDt, hit)
+	}
+}
+
+// drawVelocityVector draws an arrow from pos reaching where the ball would be in 0.1 s,
+// optionally with its horizontal and vertical components closing a right triangle
+func (g *Game) drawVelocityVector(screen *ebiten.Image, pos, vel Vector2) {
+	scale := 0.1 * g.scale
+	end := Vector2{pos.X + vel.X*scale, pos.Y - vel.Y*scale}
+
+	if g.components {
+		corner := Vector2{end.X, pos.Y}
+		drawArrow(screen, pos, corner, color.RGBA{80, 160, 255, 255}, g.antialias)
+		drawArrow(screen, corner, end, color.RGBA{255, 160, 0, 255}, g.antialias)
+	}
+	drawArrow(screen, pos, end, color.RGBA{0, 255, 0, 255}, g.antialias)
+}
+
+// powerFraction maps a launch power onto 0..1 across the allowed power range
+func powerFraction(power float64) float64 {
+	f := (power - minPower) / (maxPower - minPower)
+	return math.Max(0, math.Min(1, f))
+}
+
+// drawAimGauge draws a protractor arc filled up to the aim angle and a power bar below the cannon
+func (g *Game) drawAimGauge(screen *ebiten.Image) {
+	cx, cy := float32(g.cannon.X), float32(g.cannon.Y)
+	radius := float32(45)
+
+	// Protractor outline over the whole aim range, below horizontal too
+	for a := minAimAngle; a < maxAimAngle; a += 5 {
+		a0 := a * math.Pi / 180.0
+		a1 := (a + 5) * math.Pi / 180.0
+		strokeLine(screen, cx+radius*float32(math.Cos(a0)), cy-radius*float32(math.Sin(a0)),
+			cx+radius*float32(math.Cos(a1)), cy-radius*float32(math.Sin(a1)), 1, color.RGBA{255, 255, 255, 180}, g.antialias)
+	}
+	if g.snapAim {
+		g.drawSnapTicks(screen, cx, cy, radius)
+	}
+
+	// Filled wedge from horizontal to the current angle
+	angle := g.launchAngle()
+	for a := math.Min(0, angle); a <= math.Max(0, angle); a += 0.5 {
+		angleRad := a * math.Pi / 180.0
+		strokeLine(screen, cx, cy, cx+radius*float32(math.Cos(angleRad)), cy-radius*float32(math.Sin(angleRad)),
+			2, color.RGBA{255, 255, 0, 60}, g.antialias)
+	}
+
+	labelRad := angle * math.Pi / 180.0
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.0f°", angle),
+		int(g.cannon.X+(float64(radius)+8)*math.Cos(labelRad)), int(g.cannon.Y-(float64(radius)+8)*math.Sin(labelRad))-8)
+
+	// Power gauge bar
+	barX, barY := cx-40, cy+30
+	barWidth, barHeight := float32(80), float32(8)
+	fillRect(screen, barX, barY, barWidth, barHeight, color.RGBA{0, 0, 0, 128}, g.antialias)
+	fillRect(screen, barX, barY, barWidth*float32(powerFraction(g.aimPower)), barHeight, color.RGBA{255, 140, 0, 255}, g.antialias)
+	strokeRect(screen, barX, barY, barWidth, barHeight, 1, color.RGBA{255, 255, 255, 180}, g.antialias)
+}
+
+// uiTexts returns the lines of the left panel, below its sliders
+func (g *Game) uiTexts() []string {
+	return []string{
+		g.clocksText(time.Now()),
+		g.stopwatchText(time.Now()),
+		fmt.Sprintf("Aim assist: %.0f%% (firing at %.1f°)", g.aimAssist*100, g.launchAngle()),
+		g.timeToTargetText(),
+		fmt.Sprintf("Level: %d", g.level),
 		fmt.Sprintf("Score: %d", g.score),
+		g.playerText(),
+		g.comboText(),
+		fmt.Sprintf("Best: %d  Fewest shots/level: %s", g.highScore.BestScore, limitText(g.highScore.FewestAttempts)),
 		fmt.Sprintf("Attempts: %d", g.attempts),
+		fmt.Sprintf("Trail: %s", g.ball.TrimDescription()),
+		fmt.Sprintf("Bounce: %s (limit %s)", onOff(g.bounce), limitText(g.bounceLimit)),
+		fmt.Sprintf("Restitution: ground %.2f, walls %.2f, blocks %.2f", g.surfaces.Ground, g.surfaces.Walls, g.surfaces.Obstacles),
+		fmt.Sprintf("Dodging targets: %s", onOff(g.dodgeMode)),
+		fmt.Sprintf("Rocket: %s", onOff(g.rocket != nil)),
+		fmt.Sprintf("Two-stage: %s", onOff(g.twoStage)),
+		g.spreadText(),
+		fmt.Sprintf("Projectile: %s (radius %.0f px)", projectiles[g.projectile].Name, g.ball.Radius),
+		spinText(g.spin),
+		g.integratorText(),
+		g.coriolisText(),
+		terminalVelocityText(g.currentProjectile(), g.gravity, g.config.AirDensity, g.scale),
+		fmt.Sprintf("Launch height: %.1f m", g.launchHeight),
+		trajectoryEquation(g.launchAngle(), g.aimPower, g.gravity),
+		fmt.Sprintf("Saved presets: %d", len(g.presets)),
+		fmt.Sprintf("Trace: %s (%d dots)", onOff(g.traceMode), len(g.trace)),
+		fmt.Sprintf("Walls: left %s, top %s, right %s", onOff(g.walls.Left), onOff(g.walls.Top), onOff(g.walls.Right)),
+		fmt.Sprintf("Wind: %+.1f m/s² (gusts %s, now %+.1f)", g.wind.Base, onOff(g.wind.Gusts), g.wind.WindAt(g.time)),
 		"",
-		"Controls:",
-		"Arrow Keys: Aim & Power",
-		"Space: Launch/Reset",
-		"T: Toggle Trail",
-		"V: Toggle Vectors",
-		"P: Pause",
-		"R: Reset Game",
-	}
-	
+		"H: Help",
+	}
+}
+
+func (g *Game) drawUI(screen *ebiten.Image) {
+	// Draw text information
+	texts := g.uiTexts()
+
+	// Draw semi-transparent background for UI, with the sliders above the text
+	sliders := g.sliders()
+	sliderBlock := len(sliders) * sliderRowHeight
+	panelW, _ := hudPanelSize(len(texts), 1)
+	fillRect(screen, 10, 10, float32(panelW), float32(uiPanelHeight(len(texts), len(sliders))), color.RGBA{0, 0, 0, 128}, g.antialias)
+
+	for i, s := range sliders {
+		s.Draw(screen, i == g.dragSlider, g.antialias)
+	}
 	for i, text := range texts {
-		ebitenutil.DebugPrintAt(screen, text, 20, 20+i*15)
+		ebitenutil.DebugPrintAt(screen, text, 20, 20+sliderBlock+i*panelLineHeight)
+	}
+
+	g.drawMessage(screen)
+	g.toasts.Draw(screen, g.antialias)
+	g.drawShotLog(screen)
+	if g.showRangePlot {
+		g.drawRangePlot(screen)
 	}
-	
+
 	// Draw physics info
+	var physicsTexts []string
+	energyRow, ke, pe := -1, 0.0, 0.0
 	if g.ball.Launched {
-		physicsTexts := []string{
+		physicsTexts = []string{
 			fmt.Sprintf("Time: %.2f s", g.ball.Time),
-			fmt.Sprintf("Height: %.1f m", (float64(screenHeight-groundHeight)-g.ball.Position.Y)/g.scale),
+			fmt.Sprintf("Height: %.1f m", g.heightAboveGround(g.ball.Position)),
 			fmt.Sprintf("Distance: %.1f m", (g.ball.Position.X-g.cannon.X)/g.scale),
 			fmt.Sprintf("Vx: %.1f m/s", g.ball.Velocity.X),
 			fmt.Sprintf("Vy: %.1f m/s", g.ball.Velocity.Y),
 		}
-		
-		for i, text := range physicsTexts {
-			ebitenutil.DebugPrintAt(screen, text, screenWidth-200, 20+i*15)
+
+		// Energy relative to the launch height, with a stacked bar on the row after it
+		ke, pe = energies(g.ball, g.gravity, g.ball.Params.Start.Y, g.scale)
+		physicsTexts = append(physicsTexts, energyText(ke, pe))
+		energyRow = len(physicsTexts)
+		physicsTexts = append(physicsTexts, "")
+
+		if g.bounce {
+			physicsTexts = append(physicsTexts, fmt.Sprintf("Bounces: %d/%s", g.ball.Bounces, limitText(g.bounceLimit)))
+		}
+		if r := g.ball.Params.Rocket; r != nil && r.Burning(g.ball.Time) {
+			physicsTexts = append(physicsTexts,
+				fmt.Sprintf("Mass: %.2f kg", r.MassAt(g.ball.Time)),
+				fmt.Sprintf("Thrust accel: %.1f m/s²", r.AccelAt(g.ball.Time)))
+		}
+		if g.ball.Rolling && !g.ball.Landed {
+			a := frictionAccel(g.ball.Velocity, g.friction, g.gravity)
+			physicsTexts = append(physicsTexts, fmt.Sprintf("Friction: %.2f m/s²", a.X))
 		}
+		physicsTexts = append(physicsTexts, "")
 	}
-	
-	if g.paused {
-		ebitenutil.DebugPrintAt(screen, "PAUSED", screenWidth/2-30, screenHeight/2)
+	if g.hasImpact {
+		speed, angle := impactInfo(g.impactVel)
+		physicsTexts = append(physicsTexts,
+			"Last Shot",
+			fmt.Sprintf("Impact speed: %.1f m/s", speed),
+			fmt.Sprintf("Impact angle: %.1f°", angle))
+	}
+
+	for i, text := range physicsTexts {
+		ebitenutil.DebugPrintAt(screen, text, screen.Bounds().Dx()-200, 20+i*panelLineHeight)
+	}
+	if energyRow >= 0 {
+		m, v0 := g.ball.Params.Projectile.Mass, g.ball.Params.Power
+		drawEnergyBar(screen, float32(screen.Bounds().Dx()-200), float32(20+energyRow*panelLineHeight+3), 180,
+			ke, pe, 0.5*m*v0*v0, g.antialias)
 	}
 }
 
+func onOff(v bool) string {
+	if v {
+		return "on"
+	}
+	return "off"
+}
+
+func limitText(limit int) string {
+	if limit == 0 {
+		return "none"
+	}
+	return fmt.Sprint(limit)
+}
+
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 	return screenWidth, screenHeight
 }
 
 func main() {
-	game := NewGame()
-	
+	seed := flag.Int64("seed", defaultSeed, "seed for target placement, wind gusts and effects")
+	flag.Parse()
+
+	game := NewGame(*seed)
+
 	ebiten.SetWindowSize(screenWidth, screenHeight)
 	ebiten.SetWindowTitle("Physics Simulator - Projectile Motion")
 	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
-	
+	ebiten.SetWindowClosingHandled(true)
+
 	if err := ebiten.RunGame(game); err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"math"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestMain runs the tests in a scratch directory, so the config, records and
+// captures the game writes never land in the source tree
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "projectile_sim")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// approxEqual reports whether a and b differ by at most tol
+func approxEqual(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+// newTestGame builds a game from the default config without touching any files
+func newTestGame() *Game {
+	return newGameWithConfig(defaultSeed, DefaultConfig())
+}
+
+func TestPowerFraction(t *testing.T) {
+	tests := []struct {
+		power float64
+		want  float64
+	}{
+		{minPower, 0},
+		{maxPower, 1},
+		{(minPower + maxPower) / 2, 0.5},
+		{minPower - 10, 0},
+		{maxPower + 10, 1},
+	}
+	for _, tt := range tests {
+		if got := powerFraction(tt.power); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("powerFraction(%v) = %v, want %v", tt.power, got, tt.want)
+		}
+	}
+}
+
+func TestHitTargetHP(t *testing.T) {
+	tests := []struct {
+		hp   int
+		hits int // hits that leave it standing
+	}{
+		{1, 0},
+		{2, 1},
+		{3, 2},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		// A second target keeps the level from ending when the first goes
+		g.targets = []Target{NewTarget(600, 400, tt.hp), NewTarget(900, 400, 1)}
+		for i := 0; i < tt.hits; i++ {
+			g.hitTarget(0)
+			if len(g.targets) != 2 || g.targets[0].HP != tt.hp-i-1 {
+				t.Fatalf("%d hp target after %d hits: %d targets, hp %d, want it standing with %d hp",
+					tt.hp, i+1, len(g.targets), g.targets[0].HP, tt.hp-i-1)
+			}
+		}
+		g.hitTarget(0)
+		if len(g.targets) != 1 {
+			t.Errorf("%d hp target after %d hits: %d targets left, want 1", tt.hp, tt.hits+1, len(g.targets))
+		}
+	}
+}
+
+func TestCanBounce(t *testing.T) {
+	tests := []struct {
+		bounce  bool
+		limit   int
+		bounces int
+		vy      float64
+		want    bool
+	}{
+		{false, 0, 0, -10, false},
+		{true, 0, 7, -10, true},
+		{true, 3, 2, -10, true},
+		{true, 3, 3, -10, false},
+		{true, 0, 0, -1, false}, // too slow to rebound
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.bounce, g.bounceLimit = tt.bounce, tt.limit
+		g.ball.Bounces, g.ball.Velocity = tt.bounces, Vector2{3, tt.vy}
+		if got := g.canBounce(); got != tt.want {
+			t.Errorf("canBounce with bounce %v, limit %d, %d bounces, vy %v = %v, want %v",
+				tt.bounce, tt.limit, tt.bounces, tt.vy, got, tt.want)
+		}
+	}
+}
+
+func TestBounceLimitEndsShot(t *testing.T) {
+	for _, limit := range []int{1, 2, 3} {
+		g := newTestGame()
+		g.targets, g.obstacles = nil, nil
+		g.bounce, g.bounceLimit = true, limit
+		g.aimAngle, g.aimPower = 80, 12
+		g.launch()
+		for i := 0; i < 20/physicsDt && !g.ball.Landed; i++ {
+			g.step(physicsDt)
+		}
+		if !g.ball.Landed {
+			t.Fatalf("limit %d: shot never ended", limit)
+		}
+		if g.ball.Bounces != limit {
+			t.Errorf("limit %d: ball bounced %d times", limit, g.ball.Bounces)
+		}
+	}
+}
+
+func TestClampTargetIndex(t *testing.T) {
+	tests := []struct {
+		index, count, want int
+	}{
+		{0, 3, 0},
+		{2, 3, 2},
+		{3, 3, 2},
+		{5, 2, 1},
+		{-1, 3, 0},
+		{0, 0, 0},
+		{2, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := clampTargetIndex(tt.index, tt.count); got != tt.want {
+			t.Errorf("clampTargetIndex(%d, %d) = %d, want %d", tt.index, tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestActiveTargetAfterDestroy(t *testing.T) {
+	tests := []struct {
+		active, destroyed, want int
+	}{
+		{0, 2, 0}, // a later target goes, the selection stays put
+		{2, 0, 1}, // an earlier target goes, the selection follows its target
+		{1, 1, 1}, // the selected target goes, the next one takes over
+		{3, 3, 2}, // the selected last target goes, the new last one takes over
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets = []Target{NewTarget(500, 300, 1), NewTarget(600, 300, 1), NewTarget(700, 300, 1), NewTarget(800, 300, 1)}
+		g.activeTarget = tt.active
+		g.hitTarget(tt.destroyed)
+		if g.activeTarget != tt.want {
+			t.Errorf("active %d, destroyed %d: active target = %d, want %d", tt.active, tt.destroyed, g.activeTarget, tt.want)
+		}
+	}
+}
+
+func TestAcceptsHit(t *testing.T) {
+	tests := []struct {
+		descendingOnly bool
+		vel            Vector2
+		want           bool
+	}{
+		{false, Vector2{5, 3}, true},
+		{false, Vector2{5, -3}, true},
+		{true, Vector2{5, 3}, false}, // still climbing
+		{true, Vector2{5, 0}, false}, // at the apex
+		{true, Vector2{5, -3}, true},
+	}
+	for _, tt := range tests {
+		target := NewTarget(600, 400, 1)
+		target.DescendingOnly = tt.descendingOnly
+		if got := target.acceptsHit(tt.vel); got != tt.want {
+			t.Errorf("descending only %v, vel %v: acceptsHit = %v, want %v", tt.descendingOnly, tt.vel, got, tt.want)
+		}
+	}
+}
+
+func TestFanArcRanges(t *testing.T) {
+	g := newTestGame()
+	g.targets, g.obstacles = nil, nil
+	g.aimPower = 12
+	landingX := func(angle float64) float64 {
+		_, hit := g.predictedPath(angle)
+		if hit.Kind != HitGround {
+			t.Fatalf("arc at %v° stopped with %v, want it to reach the ground", angle, hit.Kind)
+		}
+		return hit.Point.X
+	}
+	tests := []struct {
+		longer, shorter float64
+	}{
+		{45, 20},
+		{45, 65},
+		{35, 25},
+		{55, 65},
+	}
+	for _, tt := range tests {
+		if l, s := landingX(tt.longer), landingX(tt.shorter); l <= s {
+			t.Errorf("%v° arc lands at x = %.0f, not beyond the %v° arc at x = %.0f", tt.longer, l, tt.shorter, s)
+		}
+	}
+}
+
+func TestResetBallKeepsProgress(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps int // physics steps flown before the reset, -1 for until landing
+	}{
+		{"at the cannon", 0},
+		{"mid-flight", 60},
+		{"after landing", -1},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles = g.targets[:1], nil
+		g.targets[0].Position = Vector2{screenWidth - 50, 50} // out of the shot's way
+		g.score, g.attempts = 70, 4
+		g.aimAngle, g.aimPower = 50, 14
+		if tt.steps != 0 {
+			g.launch()
+		}
+		for i := 0; (tt.steps < 0 && !g.ball.Landed && i < 20/physicsDt) || i < tt.steps; i++ {
+			g.step(physicsDt)
+		}
+		score, attempts, gravity := g.score, g.attempts, g.gravity
+
+		g.ResetBall()
+		if g.score != score || g.attempts != attempts {
+			t.Errorf("%s: score %d, attempts %d after ResetBall, want %d, %d", tt.name, g.score, g.attempts, score, attempts)
+		}
+		if len(g.targets) != 1 || g.aimAngle != 50 || g.aimPower != 14 || g.gravity != gravity {
+			t.Errorf("%s: ResetBall changed the targets or settings", tt.name)
+		}
+		if g.ball.Launched || g.ball.Landed || len(g.ball.Trail) != 0 {
+			t.Errorf("%s: ball launched %v, landed %v, %d trail points after ResetBall", tt.name, g.ball.Launched, g.ball.Landed, len(g.ball.Trail))
+		}
+	}
+}
+
+func TestGroundingAccountsForRadius(t *testing.T) {
+	groundY := float64(screenHeight - groundHeight)
+	tests := []struct {
+		radius, y float64
+		vy        float64
+		want      bool
+	}{
+		{8, groundY - 8, -3, true},
+		{8, groundY - 8.5, -3, false}, // still clear of the ground
+		{8, groundY - 5, -3, true},
+		{16, groundY - 16, -3, true},
+		{16, groundY - 8, -3, true}, // where a small ball would still be falling
+		{4, groundY - 8, -3, false},
+		{8, groundY - 8, 3, false}, // rising off a bounce
+	}
+	for _, tt := range tests {
+		b := Ball{Position: Vector2{300, tt.y}, Velocity: Vector2{2, tt.vy}, Radius: tt.radius}
+		if got := b.IsGrounded(nil); got != tt.want {
+			t.Errorf("radius %v at %v px above the ground, vy %v: IsGrounded = %v, want %v",
+				tt.radius, groundY-tt.y, tt.vy, got, tt.want)
+		}
+	}
+}
+
+func TestSeedReproducesGame(t *testing.T) {
+	tests := []struct {
+		seed, other int64
+	}{
+		{1, 2},
+		{42, 43},
+		{defaultSeed, defaultSeed + 1},
+	}
+	for _, tt := range tests {
+		a := newGameWithConfig(tt.seed, DefaultConfig())
+		b := newGameWithConfig(tt.seed, DefaultConfig())
+		c := newGameWithConfig(tt.other, DefaultConfig())
+		if !reflect.DeepEqual(a.targets, b.targets) {
+			t.Errorf("seed %d: two games laid out different targets", tt.seed)
+		}
+		if reflect.DeepEqual(a.targets, c.targets) {
+			t.Errorf("seeds %d and %d laid out the same targets", tt.seed, tt.other)
+		}
+
+		a.wind.Gusts, b.wind.Gusts, c.wind.Gusts = true, true, true
+		same, differs := true, false
+		for _, at := range []float64{0, 0.5, 3, 17.25, 60} {
+			same = same && a.wind.WindAt(at) == b.wind.WindAt(at)
+			differs = differs || a.wind.WindAt(at) != c.wind.WindAt(at)
+		}
+		if !same {
+			t.Errorf("seed %d: two games blew different gusts", tt.seed)
+		}
+		if !differs {
+			t.Errorf("seeds %d and %d blew the same gusts", tt.seed, tt.other)
+		}
+	}
+}
+
+func TestMaxFlightTimeResetsBall(t *testing.T) {
+	tests := []struct {
+		limit float64
+		reset bool
+	}{
+		{1, true},
+		{2.5, true},
+		{5, true},
+		{0, false}, // no limit
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles = nil, nil
+		g.gravity, g.maxFlightTime = 0.5, tt.limit // far too weak to bring the ball down in time
+		g.aimAngle, g.aimPower = 80, 20
+		g.launch()
+		attempts := g.attempts
+		steps := 0
+		for ; g.ball.Launched && steps < 6/physicsDt; steps++ {
+			g.step(physicsDt)
+		}
+
+		if g.ball.Launched == tt.reset {
+			t.Fatalf("limit %v s: ball launched %v after %d steps", tt.limit, g.ball.Launched, steps)
+		}
+		if !tt.reset {
+			continue
+		}
+		if flight := float64(steps) * physicsDt; !approxEqual(flight, tt.limit, 2*physicsDt) {
+			t.Errorf("limit %v s: ball reset after %.3f s", tt.limit, flight)
+		}
+		if len(g.shotLog) != 1 || g.shotLog[0].Hit || g.attempts != attempts {
+			t.Errorf("limit %v s: shot log %+v, attempts %d, want one miss and %d attempts", tt.limit, g.shotLog, g.attempts, attempts)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// measuredDistance returns the distance in meters between two world points given in pixels
+func measuredDistance(a, b Vector2, scale float64) float64 {
+	return b.Add(a.Scale(-1)).Magnitude() / scale
+}
+
+// angleBetween returns the angle in degrees, between 0 and 180, between the
+// rays from vertex through a and from vertex through b. A ray of zero length
+// has no direction, so the angle is 0.
+func angleBetween(vertex, a, b Vector2) float64 {
+	u, v := a.Add(vertex.Scale(-1)), b.Add(vertex.Scale(-1))
+	if u.Magnitude() == 0 || v.Magnitude() == 0 {
+		return 0
+	}
+	cross := u.X*v.Y - u.Y*v.X
+	dot := u.X*v.X + u.Y*v.Y
+	return math.Abs(math.Atan2(cross, dot)) * 180 / math.Pi
+}
+
+// updateMeasure collects shift-clicked points in world space. A third click starts a new measurement.
+func (g *Game) updateMeasure() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.measure = nil
+		g.protractor = nil
+	}
+	g.updateProtractor()
+	if !ebiten.IsKeyPressed(ebiten.KeyShift) || !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return
+	}
+
+	x, y := ebiten.CursorPosition()
+	p := g.camera.ScreenToWorld(Vector2{float64(x), float64(y)})
+	if len(g.measure) >= 2 {
+		g.measure = nil
+	}
+	g.measure = append(g.measure, p)
+}
+
+func (g *Game) drawMeasure(screen *ebiten.Image) {
+	lineColor := color.RGBA{255, 255, 255, 255}
+	for _, p := range g.measure {
+		strokeCircle(screen, float32(p.X), float32(p.Y), 4, 2, lineColor, g.antialias)
+	}
+	if len(g.measure) < 2 {
+		return
+	}
+
+	a, b := g.measure[0], g.measure[1]
+	strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 2, lineColor, g.antialias)
+
+	mid := a.Add(b).Scale(0.5)
+	label := fmt.Sprintf("%.2f m", measuredDistance(a, b, g.scale))
+	ebitenutil.DebugPrintAt(screen, label, int(mid.X)+6, int(mid.Y)-18)
+}
+
+const protractorArcRadius = 30.0
+
+// updateProtractor collects ctrl-clicked points in world space: the vertex
+// first, then one point on each arm. A fourth click starts a new angle.
+func (g *Game) updateProtractor() {
+	if !ebiten.IsKeyPressed(ebiten.KeyControl) || !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return
+	}
+	if len(g.protractor) >= 3 {
+		g.protractor = nil
+	}
+	g.protractor = append(g.protractor, g.cursorWorld())
+}
+
+// drawProtractor draws the protractor's arms, the arc between them and the angle in degrees
+func (g *Game) drawProtractor(screen *ebiten.Image) {
+	lineColor := color.RGBA{255, 220, 120, 255}
+	for _, p := range g.protractor {
+		strokeCircle(screen, float32(p.X), float32(p.Y), 4, 2, lineColor, g.antialias)
+	}
+	if len(g.protractor) < 2 {
+		return
+	}
+
+	vertex := g.protractor[0]
+	for _, p := range g.protractor[1:] {
+		strokeLine(screen, float32(vertex.X), float32(vertex.Y), float32(p.X), float32(p.Y), 2, lineColor, g.antialias)
+	}
+	if len(g.protractor) < 3 {
+		return
+	}
+
+	a, b := g.protractor[1], g.protractor[2]
+	start := math.Atan2(a.Y-vertex.Y, a.X-vertex.X)
+	sweep := math.Remainder(math.Atan2(b.Y-vertex.Y, b.X-vertex.X)-start, 2*math.Pi)
+	const segments = 24
+	prev := vertex.Add(Vector2{math.Cos(start), math.Sin(start)}.Scale(protractorArcRadius))
+	for i := 1; i <= segments; i++ {
+		angle := start + sweep*float64(i)/segments
+		p := vertex.Add(Vector2{math.Cos(angle), math.Sin(angle)}.Scale(protractorArcRadius))
+		strokeLine(screen, float32(prev.X), float32(prev.Y), float32(p.X), float32(p.Y), 2, lineColor, g.antialias)
+		prev = p
+	}
+
+	mid := start + sweep/2
+	label := vertex.Add(Vector2{math.Cos(mid), math.Sin(mid)}.Scale(protractorArcRadius + 12))
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.1f°", angleBetween(vertex, a, b)), int(label.X)-12, int(label.Y)-8)
+}
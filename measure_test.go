@@ -0,0 +1,48 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMeasuredDistance(t *testing.T) {
+	tests := []struct {
+		a, b  Vector2
+		scale float64
+		want  float64
+	}{
+		{Vector2{0, 0}, Vector2{0, 0}, 50, 0},
+		{Vector2{100, 200}, Vector2{200, 200}, 50, 2},
+		{Vector2{0, 0}, Vector2{30, 40}, 50, 1},
+		{Vector2{30, 40}, Vector2{0, 0}, 10, 5},
+		{Vector2{0, 0}, Vector2{0, -75}, 25, 3},
+	}
+	for _, tt := range tests {
+		if got := measuredDistance(tt.a, tt.b, tt.scale); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("measuredDistance(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestAngleBetween(t *testing.T) {
+	v := Vector2{100, 100}
+	tests := []struct {
+		name string
+		a, b Vector2
+		want float64
+	}{
+		{"right angle", Vector2{150, 100}, Vector2{100, 50}, 90},
+		{"right angle, arms swapped", Vector2{100, 50}, Vector2{150, 100}, 90},
+		{"45°", Vector2{200, 100}, Vector2{130, 70}, 45},
+		{"60°", Vector2{110, 100}, Vector2{105, 100 - 5*math.Sqrt(3)}, 60},
+		{"obtuse", Vector2{200, 100}, Vector2{0, 0}, 135},
+		{"straight", Vector2{200, 100}, Vector2{0, 100}, 180},
+		{"same ray", Vector2{120, 120}, Vector2{150, 150}, 0},
+		{"zero-length arm", v, Vector2{150, 100}, 0},
+	}
+	for _, tt := range tests {
+		if got := angleBetween(v, tt.a, tt.b); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("%s: angleBetween(%v, %v, %v) = %v, want %v", tt.name, v, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	minimapScale  = 0.15 // minimap pixels per world pixel
+	minimapWidth  = screenWidth * minimapScale
+	minimapHeight = screenHeight * minimapScale
+	minimapX      = 10
+	minimapY      = screenHeight - minimapHeight - 34 // clear of the photo mode hint bar
+
+	minimapGroundStep = 20.0 // world pixels per column of minimap ground
+)
+
+// worldToMinimap maps a world position onto the minimap drawn at origin with the given scale
+func worldToMinimap(p, origin Vector2, scale float64) Vector2 {
+	return Vector2{origin.X + p.X*scale, origin.Y + p.Y*scale}
+}
+
+// minimapToWorld is the inverse of worldToMinimap
+func minimapToWorld(p, origin Vector2, scale float64) Vector2 {
+	return Vector2{(p.X - origin.X) / scale, (p.Y - origin.Y) / scale}
+}
+
+// showMinimap reports whether the camera has moved off the default view, so part of the world may be hidden
+func (g *Game) showMinimap() bool {
+	return g.camera != NewCamera()
+}
+
+func minimapContains(x, y int) bool {
+	return float64(x) >= minimapX && float64(x) < minimapX+minimapWidth &&
+		float64(y) >= minimapY && float64(y) < minimapY+minimapHeight
+}
+
+// updateMinimap recenters the camera on a clicked minimap point and reports whether it took the click
+func (g *Game) updateMinimap() bool {
+	if !g.showMinimap() || g.cinematic || !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return false
+	}
+	x, y := ebiten.CursorPosition()
+	if !minimapContains(x, y) {
+		return false
+	}
+	p := minimapToWorld(Vector2{float64(x), float64(y)}, Vector2{minimapX, minimapY}, minimapScale)
+	g.camera.Offset = Vector2{p.X - screenWidth/2/g.camera.Zoom, p.Y - screenHeight/2/g.camera.Zoom}
+	return true
+}
+
+// drawMinimap draws the whole world, the shot, the targets and the camera's view in a corner of the screen
+func (g *Game) drawMinimap(screen *ebiten.Image) {
+	origin := Vector2{minimapX, minimapY}
+	at := func(p Vector2) (float32, float32) {
+		m := worldToMinimap(p, origin, minimapScale)
+		return float32(m.X), float32(m.Y)
+	}
+
+	fillRect(screen, minimapX, minimapY, minimapWidth, minimapHeight, skyColor, g.antialias)
+	// The ground in columns following the terrain
+	for x := 0.0; x < screenWidth; x += minimapGroundStep {
+		gx, gy := at(Vector2{x, g.terrain.SurfaceY(x + minimapGroundStep/2)})
+		fillRect(screen, gx, gy, minimapGroundStep*minimapScale, minimapY+minimapHeight-gy, color.RGBA{34, 139, 34, 255}, g.antialias)
+	}
+
+	// The flight in progress, or the last one once it has landed
+	var path []Vector2
+	if g.ball.Launched {
+		for _, p := range g.ball.Trail {
+			path = append(path, p.Pos)
+		}
+	} else {
+		for _, s := range g.lastShot {
+			path = append(path, s.Pos)
+		}
+	}
+	for i := 1; i < len(path); i++ {
+		x0, y0 := at(path[i-1])
+		x1, y1 := at(path[i])
+		strokeLine(screen, x0, y0, x1, y1, 1, color.RGBA{255, 255, 255, 255}, g.antialias)
+	}
+
+	for _, t := range g.targets {
+		x, y := at(t.Position)
+		fillCircle(screen, x, y, 2.5, t.Color(), g.antialias)
+	}
+	cx, cy := at(g.cannon)
+	fillCircle(screen, cx, cy, 3, color.RGBA{64, 64, 64, 255}, g.antialias)
+	bx, by := at(g.ball.Position)
+	fillCircle(screen, bx, by, 2, g.ball.Color, g.antialias)
+
+	// The part of the world the camera currently shows
+	vx, vy := at(g.camera.ScreenToWorld(Vector2{0, 0}))
+	vx1, vy1 := at(g.camera.ScreenToWorld(Vector2{screenWidth, screenHeight}))
+	strokeRect(screen, vx, vy, vx1-vx, vy1-vy, 1, color.RGBA{255, 255, 0, 255}, g.antialias)
+
+	strokeRect(screen, minimapX, minimapY, minimapWidth, minimapHeight, 2, color.RGBA{40, 40, 40, 255}, g.antialias)
+}
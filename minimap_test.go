@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestMinimapMapping(t *testing.T) {
+	origin := Vector2{minimapX, minimapY}
+	tests := []struct {
+		world, want Vector2
+	}{
+		{Vector2{0, 0}, origin},
+		{Vector2{screenWidth, screenHeight}, Vector2{minimapX + minimapWidth, minimapY + minimapHeight}},
+		{Vector2{screenWidth / 2, screenHeight / 2}, Vector2{minimapX + minimapWidth/2, minimapY + minimapHeight/2}},
+		{Vector2{200, -100}, Vector2{minimapX + 30, minimapY - 15}}, // above the screen stays above the map
+	}
+	for _, tt := range tests {
+		got := worldToMinimap(tt.world, origin, minimapScale)
+		if !approxEqual(got.X, tt.want.X, 1e-9) || !approxEqual(got.Y, tt.want.Y, 1e-9) {
+			t.Errorf("worldToMinimap(%v) = %v, want %v", tt.world, got, tt.want)
+		}
+		back := minimapToWorld(got, origin, minimapScale)
+		if !approxEqual(back.X, tt.world.X, 1e-9) || !approxEqual(back.Y, tt.world.Y, 1e-9) {
+			t.Errorf("minimapToWorld(worldToMinimap(%v)) = %v", tt.world, back)
+		}
+	}
+}
+
+func TestMinimapContains(t *testing.T) {
+	tests := []struct {
+		x, y int
+		want bool
+	}{
+		{minimapX, int(minimapY), true},
+		{minimapX + 20, int(minimapY) + 20, true},
+		{minimapX - 1, int(minimapY) + 20, false},
+		{minimapX + 20, int(minimapY + minimapHeight), false},
+		{screenWidth / 2, screenHeight / 2, false},
+	}
+	for _, tt := range tests {
+		if got := minimapContains(tt.x, tt.y); got != tt.want {
+			t.Errorf("minimapContains(%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	nearMissFactor = 2.0 // passes closer than this many hit radii without hitting are near misses
+	nearMissTime   = 1.5 // seconds the near-miss ring stays up
+)
+
+// closestApproach returns how close a path of positions comes to target, in pixels
+func closestApproach(path []Vector2, target Vector2) float64 {
+	_, d := closestApproachPoint(path, target)
+	return d
+}
+
+// closestApproachPoint returns the point of the path nearest to target and
+// its distance, checking along every segment rather than only at the samples
+func closestApproachPoint(path []Vector2, target Vector2) (Vector2, float64) {
+	if len(path) == 0 {
+		return target, math.Inf(1)
+	}
+	best, bestDist := path[0], path[0].Add(target.Scale(-1)).Magnitude()
+	for i := 1; i < len(path); i++ {
+		p := closestPointOnSegment(target, path[i-1], path[i])
+		if d := p.Add(target.Scale(-1)).Magnitude(); d < bestDist {
+			best, bestDist = p, d
+		}
+	}
+	return best, bestDist
+}
+
+// isNearMiss reports whether a closest approach grazed a target with the given hit radius without hitting it
+func isNearMiss(d, hitRadius float64) bool {
+	return d >= hitRadius && d < nearMissFactor*hitRadius
+}
+
+// markNearMiss remembers where the ball passed closest to a target it narrowly
+// missed, so the spot can be ringed. It reports whether there was a near miss.
+func (g *Game) markNearMiss() bool {
+	found, closest := false, math.Inf(1)
+	for _, t := range g.targets {
+		if isNearMiss(t.ClosestApproach, t.HitRadius()) && t.ClosestApproach < closest {
+			found, closest = true, t.ClosestApproach
+			g.nearMissPoint = t.ClosestPoint
+		}
+	}
+	if found {
+		g.nearMissTimer = nearMissTime
+	}
+	return found
+}
+
+// drawNearMiss draws a faint ring, fading out, where the last shot passed closest to a target
+func (g *Game) drawNearMiss(screen *ebiten.Image) {
+	if g.nearMissTimer <= 0 {
+		return
+	}
+	alpha := uint8(120 * g.nearMissTimer / nearMissTime)
+	p := g.nearMissPoint
+	strokeCircle(screen, float32(p.X), float32(p.Y), 12, 2, color.RGBA{255, 255, 255, alpha}, g.antialias)
+}
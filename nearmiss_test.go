@@ -0,0 +1,46 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClosestApproach(t *testing.T) {
+	target := Vector2{500, 300}
+	tests := []struct {
+		name string
+		path []Vector2
+		want float64
+	}{
+		{"grazes between samples", []Vector2{{400, 280}, {600, 280}}, 20},
+		{"passes over the top", []Vector2{{440, 350}, {480, 275}, {520, 275}, {560, 350}}, 25},
+		{"ends short", []Vector2{{300, 300}, {450, 300}}, 50},
+		{"single point", []Vector2{{503, 304}}, 5},
+		{"straight through", []Vector2{{400, 200}, {600, 400}}, 0},
+		{"no path", nil, math.Inf(1)},
+	}
+	for _, tt := range tests {
+		if got := closestApproach(tt.path, target); !approxEqual(got, tt.want, 1e-9) && got != tt.want {
+			t.Errorf("%s: closestApproach = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsNearMiss(t *testing.T) {
+	const r = 20.0
+	tests := []struct {
+		d    float64
+		want bool
+	}{
+		{10, false}, // a hit
+		{r, true},
+		{30, true},
+		{2 * r, false},
+		{100, false},
+	}
+	for _, tt := range tests {
+		if got := isNearMiss(tt.d, r); got != tt.want {
+			t.Errorf("isNearMiss(%v, %v) = %v, want %v", tt.d, r, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	maxParticles          = 512   // smoke, debris and dirt in the pool
+	maxExplosionParticles = 400   // explosion sparks alive at once, the oldest go first
+	explosionSpeed        = 400.0 // px/s, the fastest spark
+	explosionLife         = 1.0   // seconds, the longest a spark lasts
+	explosionGravity      = 300.0 // px/s², lighter than the ball's so sparks hang a while
+)
+
+// Particle is one short-lived speck of smoke, debris or dirt. Positions and
+// velocities are in screen pixels, Y down.
+type Particle struct {
+	Pos   Vector2
+	Vel   Vector2
+	Age   float64
+	Life  float64
+	Size  float32
+	Color color.RGBA
+	Alive bool
+}
+
+// ParticleSystem keeps every effect in one fixed pool. Spawning reuses free
+// slots and, once the pool is full, overwrites the oldest particle, so the
+// count never exceeds the cap and nothing is allocated after construction.
+// Its own seeded generator keeps effects identical from run to run.
+type ParticleSystem struct {
+	pool [maxParticles]Particle
+	next int
+	rng  *rand.Rand
+}
+
+func NewParticleSystem(seed int64) *ParticleSystem {
+	return &ParticleSystem{rng: rand.New(rand.NewSource(seed))}
+}
+
+// Spawn emits n particles at pos, flying in random directions within spread
+// radians of dir at up to speed px/s and living for up to life seconds
+func (ps *ParticleSystem) Spawn(pos Vector2, n int, dir, spread, speed, life float64, c color.RGBA) {
+	for ; n > 0; n-- {
+		slot := ps.freeSlot()
+		a := dir + (ps.rng.Float64()*2-1)*spread
+		s := speed * (0.3 + 0.7*ps.rng.Float64())
+		ps.pool[slot] = Particle{
+			Pos:   pos,
+			Vel:   Vector2{math.Cos(a) * s, -math.Sin(a) * s},
+			Life:  life * (0.5 + 0.5*ps.rng.Float64()),
+			Size:  float32(1.5 + 2*ps.rng.Float64()),
+			Color: c,
+			Alive: true,
+		}
+	}
+}
+
+// freeSlot returns the first dead slot from the cursor on, or the slot under
+// the cursor when every particle is alive. Slots fill in order, so that one
+// holds the oldest particle.
+func (ps *ParticleSystem) freeSlot() int {
+	for i := 0; i < maxParticles; i++ {
+		slot := (ps.next + i) % maxParticles
+		if !ps.pool[slot].Alive {
+			ps.next = (slot + 1) % maxParticles
+			return slot
+		}
+	}
+	slot := ps.next
+	ps.next = (ps.next + 1) % maxParticles
+	return slot
+}
+
+// Update ages and moves every live particle, pulling it down by gravity px/s²
+func (ps *ParticleSystem) Update(dt, gravity float64) {
+	for i := range ps.pool {
+		p := &ps.pool[i]
+		if !p.Alive {
+			continue
+		}
+		p.Age += dt
+		if p.Age >= p.Life {
+			p.Alive = false
+			continue
+		}
+		p.Vel.Y += gravity * dt
+		p.Pos = p.Pos.Add(p.Vel.Scale(dt))
+	}
+}
+
+// Count returns the number of live particles
+func (ps *ParticleSystem) Count() int {
+	n := 0
+	for i := range ps.pool {
+		if ps.pool[i].Alive {
+			n++
+		}
+	}
+	return n
+}
+
+// Draw renders live particles, fading them out over their life
+func (ps *ParticleSystem) Draw(screen *ebiten.Image, antialias bool) {
+	for i := range ps.pool {
+		if ps.pool[i].Alive {
+			drawParticle(screen, ps.pool[i], antialias)
+		}
+	}
+}
+
+func drawParticle(screen *ebiten.Image, p Particle, antialias bool) {
+	c := p.Color
+	c.A = uint8(float64(c.A) * (1 - p.Age/p.Life))
+	fillCircle(screen, float32(p.Pos.X), float32(p.Pos.Y), p.Size, c, antialias)
+}
+
+// spawnExplosion returns a burst of n sparks flying out from pos in every
+// direction. Speeds, lives and colors vary from spark to spark, stepping by
+// the golden ratio so neighbouring directions differ without any randomness.
+func spawnExplosion(pos Vector2, n int) []Particle {
+	p := make([]Particle, n)
+	for i := range p {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		k := math.Mod(float64(i)*math.Phi, 1)
+		s := explosionSpeed * (0.3 + 0.7*k)
+		p[i] = Particle{
+			Pos:   pos,
+			Vel:   Vector2{math.Cos(a) * s, -math.Sin(a) * s},
+			Life:  explosionLife * (0.5 + 0.5*k),
+			Size:  float32(1.5 + 2*k),
+			Color: color.RGBA{255, uint8(200 - 140*k), 40, 255},
+			Alive: true,
+		}
+	}
+	return p
+}
+
+// updateParticles ages and moves the sparks by dt seconds under
+// explosionGravity, dropping those past their life. It filters p in place.
+func updateParticles(p []Particle, dt float64) []Particle {
+	live := p[:0]
+	for _, q := range p {
+		q.Age += dt
+		if q.Age >= q.Life {
+			continue
+		}
+		q.Vel.Y += explosionGravity * dt
+		q.Pos = q.Pos.Add(q.Vel.Scale(dt))
+		live = append(live, q)
+	}
+	return live
+}
+
+// explode adds a burst of sparks at pos, dropping the oldest sparks past
+// maxExplosionParticles
+func (g *Game) explode(pos Vector2) {
+	g.explosions = append(g.explosions, spawnExplosion(pos, 80)...)
+	if over := len(g.explosions) - maxExplosionParticles; over > 0 {
+		g.explosions = append(g.explosions[:0], g.explosions[over:]...)
+	}
+}
+
+// drawExplosions renders the sparks, fading them out over their life
+func (g *Game) drawExplosions(screen *ebiten.Image) {
+	for _, p := range g.explosions {
+		drawParticle(screen, p, g.antialias)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestParticlePoolCap(t *testing.T) {
+	tests := []struct {
+		bursts, perBurst int
+		want             int
+	}{
+		{1, 30, 30},
+		{10, 50, 500},
+		{20, 50, maxParticles},
+		{3, 1000, maxParticles},
+	}
+	for _, tt := range tests {
+		ps := NewParticleSystem(defaultSeed)
+		for i := 0; i < tt.bursts; i++ {
+			ps.Spawn(Vector2{600, 400}, tt.perBurst, 0, 3.14, 200, 1, color.RGBA{255, 255, 255, 255})
+			if n := ps.Count(); n > maxParticles {
+				t.Fatalf("%d bursts of %d: %d live particles, over the cap of %d", i+1, tt.perBurst, n, maxParticles)
+			}
+		}
+		if n := ps.Count(); n != tt.want {
+			t.Errorf("%d bursts of %d: %d live particles, want %d", tt.bursts, tt.perBurst, n, tt.want)
+		}
+	}
+}
+
+func TestParticleSlotsReused(t *testing.T) {
+	ps := NewParticleSystem(defaultSeed)
+	ps.Spawn(Vector2{600, 400}, 40, 0, 3.14, 200, 0.5, color.RGBA{255, 255, 255, 255})
+	ps.Update(1, 500) // every particle outlives its at most 0.5 s
+	if n := ps.Count(); n != 0 {
+		t.Fatalf("%d particles alive after their lifetime", n)
+	}
+
+	// Every dead slot is free again, so a full pool's worth fits
+	ps.Spawn(Vector2{600, 400}, maxParticles, 0, 3.14, 200, 0.5, color.RGBA{255, 255, 255, 255})
+	if n := ps.Count(); n != maxParticles {
+		t.Errorf("%d particles alive after refilling the pool, want %d", n, maxParticles)
+	}
+}
+
+func BenchmarkParticleUpdate(b *testing.B) {
+	ps := NewParticleSystem(defaultSeed)
+	ps.Spawn(Vector2{600, 400}, maxParticles, 0, 3.14, 200, 1e9, color.RGBA{255, 255, 255, 255})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ps.Update(1.0/240.0, 500)
+	}
+}
+
+func TestExplosionExpires(t *testing.T) {
+	const dt = 1.0 / 60.0
+	tests := []struct {
+		n                 int
+		allAlive, allDead float64 // seconds: shortest and longest possible lives
+	}{
+		{10, 0.5 * explosionLife, explosionLife},
+		{80, 0.5 * explosionLife, explosionLife},
+		{301, 0.5 * explosionLife, explosionLife},
+	}
+	for _, tt := range tests {
+		p := spawnExplosion(Vector2{600, 400}, tt.n)
+		if len(p) != tt.n {
+			t.Fatalf("spawnExplosion(%d) made %d particles", tt.n, len(p))
+		}
+		for i, q := range p {
+			if q.Pos != (Vector2{600, 400}) || q.Vel.Magnitude() == 0 || q.Life <= 0 {
+				t.Fatalf("spawnExplosion(%d) particle %d = %+v, want a live spark moving out from the center", tt.n, i, q)
+			}
+		}
+		prev := tt.n
+		for age := dt; age < tt.allDead+2*dt; age += dt {
+			p = updateParticles(p, dt)
+			n := len(p)
+			switch {
+			case n > prev:
+				t.Fatalf("explosion of %d: %d particles at %.2f s, up from %d", tt.n, n, age, prev)
+			case age < tt.allAlive-1e-9 && n != tt.n:
+				t.Errorf("explosion of %d: only %d alive at %.2f s, before any should expire", tt.n, n, age)
+			}
+			prev = n
+		}
+		if prev != 0 {
+			t.Errorf("explosion of %d: %d particles outlived their lifetime", tt.n, prev)
+		}
+	}
+}
+
+func TestExplosionsCapped(t *testing.T) {
+	g := newTestGame()
+	for i := 0; i < 20; i++ {
+		g.explode(Vector2{600, 400})
+		if len(g.explosions) > maxExplosionParticles {
+			t.Fatalf("after %d explosions: %d sparks, over the cap of %d", i+1, len(g.explosions), maxExplosionParticles)
+		}
+	}
+	g.step(explosionLife + physicsDt)
+	if n := len(g.explosions); n != 0 {
+		t.Errorf("%d sparks left after their lifetime", n)
+	}
+}
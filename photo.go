@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	photoPanSpeed = 8.0
+	photoMinZoom  = 0.5
+	photoMaxZoom  = 4.0
+)
+
+// updatePhotoMode runs instead of the normal update while photo mode is on,
+// so the simulation stays frozen and only the camera and HUD can change
+func (g *Game) updatePhotoMode() {
+	g.updateMinimap()
+
+	if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
+		g.camera.Offset.X -= photoPanSpeed / g.camera.Zoom
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
+		g.camera.Offset.X += photoPanSpeed / g.camera.Zoom
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyArrowUp) {
+		g.camera.Offset.Y -= photoPanSpeed / g.camera.Zoom
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyArrowDown) {
+		g.camera.Offset.Y += photoPanSpeed / g.camera.Zoom
+	}
+
+	center := Vector2{screenWidth / 2, screenHeight / 2}
+	if ebiten.IsKeyPressed(ebiten.KeyEqual) && g.camera.Zoom < photoMaxZoom {
+		g.camera.ZoomAt(g.camera.Zoom*1.02, center)
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyMinus) && g.camera.Zoom > photoMinZoom {
+		g.camera.ZoomAt(g.camera.Zoom/1.02, center)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key0) {
+		g.camera = NewCamera()
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		g.cinematic = !g.cinematic
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		g.photoPending = true
+	}
+}
+
+// drawPhotoOverlay draws the photo mode hints on top of the frame and performs a pending capture.
+// The capture is rendered offscreen first so the hints never end up in the picture.
+func (g *Game) drawPhotoOverlay(screen *ebiten.Image) {
+	if g.photoPending {
+		g.photoPending = false
+		path := captureName("photo", "png", time.Now())
+		if err := SavePNG(g.capturePhoto(), path); err != nil {
+			log.Printf("photo capture failed: %v", err)
+		} else {
+			log.Printf("saved %s", path)
+		}
+	}
+
+	fillRect(screen, 0, screenHeight-24, screenWidth, 24, color.RGBA{0, 0, 0, 160}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, "PHOTO MODE  Arrows: Pan  +/-: Zoom  0: Reset View  C: Hide HUD  Space: Capture  F2: Exit",
+		10, screenHeight-20)
+}
+
+// capturePhoto renders the current frame offscreen and reads it back as an RGBA image
+func (g *Game) capturePhoto() *image.RGBA {
+	offscreen := ebiten.NewImage(screenWidth, screenHeight)
+	defer offscreen.Deallocate()
+	g.drawFrame(offscreen)
+
+	img := image.NewRGBA(image.Rect(0, 0, screenWidth, screenHeight))
+	offscreen.ReadPixels(img.Pix)
+	return img
+}
+
+// saveScreenshot writes the finished frame, exactly as shown, to a timestamped PNG
+func (g *Game) saveScreenshot(screen *ebiten.Image) {
+	b := screen.Bounds()
+	img := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	screen.ReadPixels(img.Pix)
+
+	path := captureName("screenshot", "png", time.Now())
+	if err := SavePNG(img, path); err != nil {
+		g.flash("Screenshot failed: " + err.Error())
+		return
+	}
+	g.flash("Saved " + path)
+}
+
+// captureName returns a file name for a capture taken at t. The timestamp
+// goes down to the millisecond so captures in the same second do not
+// overwrite each other.
+func captureName(prefix, ext string, t time.Time) string {
+	return fmt.Sprintf("%s_%s_%03d.%s", prefix, t.Format("20060102_150405"), t.Nanosecond()/int(time.Millisecond), ext)
+}
+
+// SavePNG encodes img as a PNG file at path
+func SavePNG(img image.Image, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
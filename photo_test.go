@@ -0,0 +1,104 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPhotoModeFreezesSimulation(t *testing.T) {
+	tests := []struct {
+		name      string
+		photoMode bool
+		moves     bool
+	}{
+		{"photo mode", true, false},
+		{"normal play", false, true},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.launch()
+		g.photoMode = tt.photoMode
+		g.macro.startRecording() // recording steps a fixed 1/60 s per frame, whatever the wall clock does
+		before, ticks := g.ball.Position, g.ticks
+		for i := 0; i < 30; i++ {
+			if err := g.Update(); err != nil {
+				t.Fatalf("%s: Update: %v", tt.name, err)
+			}
+		}
+		if moved := g.ball.Position != before; moved != tt.moves {
+			t.Errorf("%s: ball moved = %v, want %v", tt.name, moved, tt.moves)
+		}
+		if g.ticks != ticks+30 {
+			t.Errorf("%s: ticks = %d, want %d", tt.name, g.ticks, ticks+30)
+		}
+	}
+}
+
+func TestSavePNGRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		w, h int
+	}{
+		{"single pixel", 1, 1},
+		{"small", 8, 5},
+		{"wide", 64, 3},
+	}
+	for _, tt := range tests {
+		img := image.NewRGBA(image.Rect(0, 0, tt.w, tt.h))
+		for y := 0; y < tt.h; y++ {
+			for x := 0; x < tt.w; x++ {
+				img.Set(x, y, color.RGBA{uint8(x * 4), uint8(y * 50), uint8(x + y), 255})
+			}
+		}
+		path := filepath.Join(t.TempDir(), "shot.png")
+		if err := SavePNG(img, path); err != nil {
+			t.Fatalf("%s: SavePNG: %v", tt.name, err)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got, err := png.Decode(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: decoding: %v", tt.name, err)
+		}
+		if got.Bounds() != img.Bounds() {
+			t.Fatalf("%s: bounds %v, want %v", tt.name, got.Bounds(), img.Bounds())
+		}
+		for y := 0; y < tt.h; y++ {
+			for x := 0; x < tt.w; x++ {
+				if c := color.RGBAModel.Convert(got.At(x, y)); c != img.At(x, y) {
+					t.Fatalf("%s: pixel (%d, %d) = %v, want %v", tt.name, x, y, c, img.At(x, y))
+				}
+			}
+		}
+	}
+
+	if err := SavePNG(image.NewRGBA(image.Rect(0, 0, 1, 1)), filepath.Join(t.TempDir(), "missing", "shot.png")); err == nil {
+		t.Errorf("SavePNG into a missing directory returned no error")
+	}
+}
+
+func TestCaptureName(t *testing.T) {
+	at := time.Date(2024, 3, 9, 14, 5, 7, 42*int(time.Millisecond)+999, time.UTC)
+	tests := []struct {
+		prefix, ext string
+		t           time.Time
+		want        string
+	}{
+		{"screenshot", "png", at, "screenshot_20240309_140507_042.png"},
+		{"photo", "png", at.Add(time.Millisecond), "photo_20240309_140507_043.png"},
+		{"shot", "gif", at.Add(958 * time.Millisecond), "shot_20240309_140508_000.gif"},
+	}
+	for _, tt := range tests {
+		if got := captureName(tt.prefix, tt.ext, tt.t); got != tt.want {
+			t.Errorf("captureName(%q, %q, %v) = %q, want %q", tt.prefix, tt.ext, tt.t, got, tt.want)
+		}
+	}
+}
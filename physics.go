@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// LaunchParams fully describes a launch. Angle is in degrees, Power in m/s,
+// Gravity in m/s², Start in screen pixels and Scale in pixels per meter.
+// Wind, when set, gives the horizontal wind acceleration in m/s² t seconds
+// into the flight. Rocket, when set, adds thrust along the direction of travel.
+// Projectile sets the ball's size, mass and drag coefficient, and AirDensity
+// in kg/m³ the air it flies through. Spin in rev/s curves the flight through
+// the Magnus effect, and Coriolis in rad/s deflects it as in a rotating
+// frame. Integrator, when set, replaces the closed-form step.
+type LaunchParams struct {
+	Angle      float64
+	Power      float64
+	Gravity    float64
+	Start      Vector2
+	Scale      float64
+	Wind       func(t float64) float64
+	Rocket     *Rocket
+	Projectile Projectile
+	AirDensity float64
+	Spin       float64
+	Coriolis   float64
+	Integrator Integrator
+}
+
+// InitialVelocity returns the launch velocity in m/s with Y pointing up
+func (p LaunchParams) InitialVelocity() Vector2 {
+	angleRad := p.Angle * math.Pi / 180.0
+	return Vector2{
+		X: p.Power * math.Cos(angleRad),
+		Y: p.Power * math.Sin(angleRad),
+	}
+}
+
+// Accel returns the acceleration acting on the projectile t seconds into the
+// flight while moving with vel, in m/s² with Y up
+func (p LaunchParams) Accel(t float64, vel Vector2) Vector2 {
+	a := Vector2{0, -p.Gravity}
+	if p.Wind != nil {
+		a.X += p.Wind(t)
+	}
+	if p.Rocket != nil && p.Rocket.Burning(t) {
+		dir := vel
+		if dir.Magnitude() == 0 {
+			dir = p.InitialVelocity()
+		}
+		a = a.Add(dir.Scale(p.Rocket.AccelAt(t) / dir.Magnitude()))
+	}
+	a = a.Add(magnusAccel(vel, p.Spin))
+	a = a.Add(coriolisAccel(vel, p.Coriolis))
+
+	proj := p.Projectile
+	return a.Add(dragAccel(vel, proj.Mass, p.AirDensity, proj.DragCoeff, proj.Area(p.Scale)))
+}
+
+// Step advances a position in pixels and a velocity in m/s by dt seconds,
+// starting t seconds into the flight. The default closed-form step is exact
+// while the acceleration is constant over the step, so a windless flight
+// matches PositionAt.
+func (p LaunchParams) Step(pos, vel Vector2, t, dt float64) (Vector2, Vector2) {
+	if p.Integrator != nil {
+		return p.Integrator.Step(p, pos, vel, t, dt)
+	}
+	return ClosedForm{}.Step(p, pos, vel, t, dt)
+}
+
+// PositionAt evaluates the vacuum projectile motion equations t seconds after launch, ignoring wind.
+// The result is in screen pixels, so the vertical displacement is subtracted.
+func (p LaunchParams) PositionAt(t float64) Vector2 {
+	v := p.InitialVelocity()
+	return Vector2{
+		X: p.Start.X + v.X*t*p.Scale,
+		Y: p.Start.Y - (v.Y*t-0.5*p.Gravity*t*t)*p.Scale,
+	}
+}
+
+// VelocityAt returns the vacuum velocity in m/s t seconds after launch, ignoring wind
+func (p LaunchParams) VelocityAt(t float64) Vector2 {
+	v := p.InitialVelocity()
+	return Vector2{v.X, v.Y - p.Gravity*t}
+}
+
+// Simulate runs a launch without any rendering and returns the position after each of the steps
+func Simulate(params LaunchParams, dt float64, steps int) []Vector2 {
+	points := make([]Vector2, 0, steps)
+	pos, vel := params.Start, params.InitialVelocity()
+	for i := 0; i < steps; i++ {
+		pos, vel = params.Step(pos, vel, float64(i)*dt, dt)
+		points = append(points, pos)
+	}
+	return points
+}
+
+// TrajectoryCoefficients returns a and b of the vacuum trajectory y = a·x + b·x²,
+// with x and y in meters from the launch point and the angle in degrees:
+// a = tan θ and b = -g / (2 v² cos² θ)
+func TrajectoryCoefficients(angle, power, gravity float64) (a, b float64) {
+	angleRad := angle * math.Pi / 180.0
+	cos := math.Cos(angleRad)
+	return math.Tan(angleRad), -gravity / (2 * power * power * cos * cos)
+}
+
+// trajectoryEquation formats the trajectory as an equation for display
+func trajectoryEquation(angle, power, gravity float64) string {
+	a, b := TrajectoryCoefficients(angle, power, gravity)
+	if math.Abs(math.Cos(angle*math.Pi/180.0)) < 1e-9 {
+		return "x = 0 (straight up)"
+	}
+	sign := "-"
+	if b > 0 {
+		sign = "+"
+	}
+	return fmt.Sprintf("y = %.3fx %s %.4fx²", a, sign, math.Abs(b))
+}
@@ -0,0 +1,77 @@
+package main
+
+import "testing"
+
+// vacuumParams is a windless, dragless launch from a fixed point on screen
+func vacuumParams(angle, power float64) LaunchParams {
+	return LaunchParams{
+		Angle:      angle,
+		Power:      power,
+		Gravity:    defaultGravity,
+		Start:      Vector2{100, 500},
+		Scale:      defaultScale,
+		Projectile: projectiles[0],
+	}
+}
+
+func TestSimulateMatchesAnalytic(t *testing.T) {
+	const dt, steps = 1.0 / 60.0, 120
+	tests := []struct {
+		angle, power float64
+	}{
+		{15, 10},
+		{45, 12},
+		{60, 20},
+		{90, 8},
+	}
+	for _, tt := range tests {
+		params := vacuumParams(tt.angle, tt.power)
+		points := Simulate(params, dt, steps)
+		if len(points) != steps {
+			t.Fatalf("Simulate(%v°, %v m/s) returned %d points, want %d", tt.angle, tt.power, len(points), steps)
+		}
+		for i, p := range points {
+			want := params.PositionAt(float64(i+1) * dt)
+			if !approxEqual(p.X, want.X, 1e-6) || !approxEqual(p.Y, want.Y, 1e-6) {
+				t.Errorf("Simulate(%v°, %v m/s) step %d = %v, want %v", tt.angle, tt.power, i+1, p, want)
+				break
+			}
+		}
+	}
+}
+
+func TestTrajectoryCoefficients(t *testing.T) {
+	tests := []struct {
+		angle, power, gravity float64
+		a, b                  float64
+	}{
+		{45, 10, 9.8, 1, -0.098},             // tan 45° = 1, 9.8 / (2·100·½)
+		{30, 20, 9.8, 0.577350, -0.016333},   // 9.8 / (2·400·¾)
+		{60, 10, 9.8, 1.732051, -0.196},      // 9.8 / (2·100·¼)
+		{0, 5, 9.8, 0, -0.196},               // 9.8 / (2·25)
+		{45, 10, 1.62, 1, -0.0162},           // the Moon
+		{-30, 20, 9.8, -0.577350, -0.016333}, // aimed downward
+	}
+	for _, tt := range tests {
+		a, b := TrajectoryCoefficients(tt.angle, tt.power, tt.gravity)
+		if !approxEqual(a, tt.a, 1e-6) || !approxEqual(b, tt.b, 1e-6) {
+			t.Errorf("TrajectoryCoefficients(%v, %v, %v) = %v, %v, want %v, %v", tt.angle, tt.power, tt.gravity, a, b, tt.a, tt.b)
+		}
+	}
+}
+
+func TestTrajectoryEquation(t *testing.T) {
+	tests := []struct {
+		angle, power, gravity float64
+		want                  string
+	}{
+		{45, 10, 9.8, "y = 1.000x - 0.0980x²"},
+		{0, 5, 9.8, "y = 0.000x - 0.1960x²"},
+		{90, 10, 9.8, "x = 0 (straight up)"},
+	}
+	for _, tt := range tests {
+		if got := trajectoryEquation(tt.angle, tt.power, tt.gravity); got != tt.want {
+			t.Errorf("trajectoryEquation(%v, %v, %v) = %q, want %q", tt.angle, tt.power, tt.gravity, got, tt.want)
+		}
+	}
+}
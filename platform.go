@@ -0,0 +1,42 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	cannonX           = 100.0
+	cannonRadius      = 20.0 // pixels
+	platformStep      = 0.5  // meters per key press
+	maxPlatformHeight = 8.0  // meters
+	platformWidth     = 60
+)
+
+// cannonPosition returns where the cannon sits on a platform height meters
+// tall, standing on the terrain
+func cannonPosition(height, scale float64, terrain Terrain) Vector2 {
+	return Vector2{cannonX, terrain.SurfaceY(cannonX) - height*scale}
+}
+
+// setPlatformHeight raises or lowers the cannon, carrying an idle ball along with it
+func (g *Game) setPlatformHeight(height float64) {
+	g.launchHeight = math.Max(0, math.Min(maxPlatformHeight, height))
+	g.cannon = cannonPosition(g.launchHeight, g.scale, g.terrain)
+	if !g.ball.Launched {
+		g.ball.Returning = false
+		g.ball.Position = g.cannon
+	}
+}
+
+func (g *Game) drawPlatform(screen *ebiten.Image) {
+	if g.launchHeight == 0 {
+		return
+	}
+	groundY := float32(g.terrain.SurfaceY(g.cannon.X))
+	top := float32(g.cannon.Y)
+	fillRect(screen, float32(g.cannon.X)-platformWidth/2, top, platformWidth, groundY-top, color.RGBA{110, 110, 120, 255}, g.antialias)
+	strokeRect(screen, float32(g.cannon.X)-platformWidth/2, top, platformWidth, groundY-top, 2, color.RGBA{70, 70, 80, 255}, g.antialias)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHorizontalLaunchFromHeight(t *testing.T) {
+	tests := []struct {
+		height, power float64
+	}{
+		{2, 5},
+		{4, 10},
+		{8, 10},
+		{8, 15},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles = nil, nil
+		g.terrain = nil
+		g.setPlatformHeight(tt.height)
+		g.aimPower = tt.power
+		_, hit := g.predictedPath(0)
+		if hit.Kind != HitGround {
+			t.Fatalf("%v m platform: shot stopped with %v, want it to reach the ground", tt.height, hit.Kind)
+		}
+		// The ball rests on the ground, so its center drops a radius short of the platform height
+		drop := tt.height - g.ball.Radius/g.scale
+		want := tt.power * math.Sqrt(2*drop/g.gravity)
+		got := (hit.Point.X - g.cannon.X) / g.scale
+		if !approxEqual(got, want, 0.01) {
+			t.Errorf("%v m/s from a %v m platform landed %.3f m out, want %.3f m", tt.power, tt.height, got, want)
+		}
+	}
+}
+
+func TestSetPlatformHeightClamps(t *testing.T) {
+	tests := []struct {
+		height, want float64
+	}{
+		{3, 3},
+		{-2, 0},
+		{maxPlatformHeight + 5, maxPlatformHeight},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.setPlatformHeight(tt.height)
+		if g.launchHeight != tt.want {
+			t.Errorf("setPlatformHeight(%v): height = %v, want %v", tt.height, g.launchHeight, tt.want)
+		}
+		if want := cannonPosition(tt.want, g.scale, g.terrain); g.cannon != want || g.ball.Position != want {
+			t.Errorf("setPlatformHeight(%v): cannon %v, ball %v, want both at %v", tt.height, g.cannon, g.ball.Position, want)
+		}
+	}
+}
+
+func TestDownhillLaunchDescends(t *testing.T) {
+	tests := []struct {
+		angle, power, height float64
+	}{
+		{-30, 10, 4},
+		{-30, 20, maxPlatformHeight},
+		{-10, 15, 6},
+		{minAimAngle, 8, 3},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles = nil, nil
+		g.setPlatformHeight(tt.height)
+		g.aimAngle, g.aimPower = tt.angle, tt.power
+		g.launch()
+		if vy := g.ball.Velocity.Y; vy >= 0 {
+			t.Fatalf("%v° from %v m: launched with vy %v, want it heading down", tt.angle, tt.height, vy)
+		}
+		prev := g.ball.Position
+		for i := 0; i < 10 && !g.ball.Landed; i++ {
+			g.step(physicsDt)
+			if g.ball.Position.Y <= prev.Y || g.ball.Position.X <= prev.X {
+				t.Fatalf("%v° from %v m: step %d went from %v to %v, want down and forward", tt.angle, tt.height, i+1, prev, g.ball.Position)
+			}
+			prev = g.ball.Position
+		}
+
+		points, hit := g.predictedPath(tt.angle)
+		if hit.Kind != HitGround || len(points) < 2 || points[1].Y <= points[0].Y {
+			t.Errorf("%v° from %v m: preview stopped with %v, first points %v, want it to fall straight away to the ground", tt.angle, tt.height, hit.Kind, points[:min(2, len(points))])
+		}
+	}
+}
+
+func TestCannonStandsOnTerrain(t *testing.T) {
+	tests := []struct {
+		terrain []float64 // meters
+		height  float64   // platform meters
+	}{
+		{nil, 0},
+		{[]float64{2}, 0},
+		{[]float64{2}, 3},
+		{[]float64{-1, -1}, 1.5},
+	}
+	for _, tt := range tests {
+		terrain := terrainFromMeters(tt.terrain, defaultScale)
+		p := cannonPosition(tt.height, defaultScale, terrain)
+		if got := metersFromPixels(terrain.SurfaceY(p.X)-p.Y, defaultScale); !approxEqual(got, tt.height, 1e-9) {
+			t.Errorf("terrain %v, platform %v m: cannon %v m above the ground, want %v", tt.terrain, tt.height, got, tt.height)
+		}
+	}
+}
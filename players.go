@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+)
+
+const numPlayers = 2
+
+// playerColors are the cannon colors of each player in two-player mode
+var playerColors = [numPlayers]color.RGBA{
+	{200, 50, 50, 255},
+	{50, 90, 210, 255},
+}
+
+// nextPlayer returns whose turn follows current's when total players take turns in order
+func nextPlayer(current, total int) int {
+	if total <= 0 {
+		return 0
+	}
+	return (current + 1) % total
+}
+
+// addScore adds points to the game score and, in two-player mode, to the
+// score of the player who fired the shot. The turn has already passed on by
+// the time the pellets of a spread shot land.
+func (g *Game) addScore(points int) {
+	g.score += points
+	if g.twoPlayer {
+		g.playerScores[g.shooter] += points
+	}
+}
+
+// endTurn passes the cannon to the next player once a shot has landed.
+// A combo never carries over from one player to the other.
+func (g *Game) endTurn() {
+	if !g.twoPlayer {
+		return
+	}
+	g.currentPlayer = nextPlayer(g.currentPlayer, numPlayers)
+	g.combo = 0
+	g.flash(fmt.Sprintf("Player %d's turn", g.currentPlayer+1))
+}
+
+// toggleTwoPlayer switches between one and two players, starting a new game
+// so both players begin level 1 on equal terms
+func (g *Game) toggleTwoPlayer() {
+	g.twoPlayer = !g.twoPlayer
+	g.reset()
+	if g.twoPlayer {
+		g.flash("Two players: Player 1 starts")
+	}
+}
+
+// cannonColor is the current player's color in two-player mode
+func (g *Game) cannonColor() color.RGBA {
+	if !g.twoPlayer {
+		return color.RGBA{64, 64, 64, 255}
+	}
+	return playerColors[g.currentPlayer]
+}
+
+// playerText shows whose turn it is and each player's score
+func (g *Game) playerText() string {
+	if !g.twoPlayer {
+		return "Players: 1"
+	}
+	return fmt.Sprintf("Player %d's turn  (P1 %d, P2 %d)", g.currentPlayer+1, g.playerScores[0], g.playerScores[1])
+}
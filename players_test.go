@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestNextPlayer(t *testing.T) {
+	tests := []struct {
+		current, total, want int
+	}{
+		{0, 2, 1},
+		{1, 2, 0},
+		{0, 3, 1},
+		{2, 3, 0},
+		{0, 1, 0},
+		{0, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := nextPlayer(tt.current, tt.total); got != tt.want {
+			t.Errorf("nextPlayer(%d, %d) = %d, want %d", tt.current, tt.total, got, tt.want)
+		}
+	}
+
+	// A full round of turns comes back to the first player
+	for total := 1; total <= 4; total++ {
+		p := 0
+		for i := 0; i < total; i++ {
+			p = nextPlayer(p, total)
+		}
+		if p != 0 {
+			t.Errorf("%d players: a round of turns ended with player %d", total, p)
+		}
+	}
+}
+
+func TestScoreCreditsShooter(t *testing.T) {
+	g := newTestGame()
+	g.twoPlayer = true
+	g.launch()
+	g.endTurn()
+	if g.currentPlayer != 1 {
+		t.Fatalf("after player 1's shot it is player %d's turn", g.currentPlayer+1)
+	}
+
+	// A pellet of player 1's spread shot lands after the turn has passed
+	g.addScore(10)
+	if g.playerScores != [numPlayers]int{10, 0} || g.score != 10 {
+		t.Errorf("player scores %v, total %d, want the points credited to player 1", g.playerScores, g.score)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const (
+	rangePlotStep    = 1.0 // degrees between points of the range curve
+	rangePlotWidth   = 230
+	rangePlotHeight  = 170
+	rangePlotOffsetX = 240 // from the right edge of the HUD, lined up with the shot log
+	rangePlotY       = 510
+)
+
+// rangeCurve returns (angle in degrees, vacuum range in meters) points for
+// launches from ground level at every stepDeg from 0° to 90°
+func rangeCurve(power, gravity, stepDeg float64) []Vector2 {
+	var points []Vector2
+	for a := 0.0; a <= 90+1e-9; a += stepDeg {
+		_, r := flightTimeAndRange(a, power, gravity, 0)
+		points = append(points, Vector2{a, r})
+	}
+	return points
+}
+
+// LinePlot is a small chart in screen pixels. Data points are mapped from
+// [0, XMax] by [0, YMax] onto the plot area, Y up.
+type LinePlot struct {
+	X, Y, W, H float64
+	XMax, YMax float64
+	Title      string
+}
+
+// toScreen maps a data point into the plot area
+func (p LinePlot) toScreen(d Vector2) Vector2 {
+	return Vector2{p.X + d.X/p.XMax*p.W, p.Y + p.H - d.Y/p.YMax*p.H}
+}
+
+// Draw draws the plot's background, axes, title and the data as a polyline
+func (p LinePlot) Draw(screen *ebiten.Image, data []Vector2, lineColor color.RGBA, antialias bool) {
+	const margin = 8
+	fillRect(screen, float32(p.X-margin), float32(p.Y-margin-14), float32(p.W+2*margin), float32(p.H+2*margin+26),
+		color.RGBA{0, 0, 0, 128}, antialias)
+	ebitenutil.DebugPrintAt(screen, p.Title, int(p.X), int(p.Y)-margin-12)
+
+	axisColor := color.RGBA{255, 255, 255, 200}
+	strokeLine(screen, float32(p.X), float32(p.Y+p.H), float32(p.X+p.W), float32(p.Y+p.H), 1, axisColor, antialias)
+	strokeLine(screen, float32(p.X), float32(p.Y), float32(p.X), float32(p.Y+p.H), 1, axisColor, antialias)
+
+	for i := 1; i < len(data); i++ {
+		a, b := p.toScreen(data[i-1]), p.toScreen(data[i])
+		strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 2, lineColor, antialias)
+	}
+}
+
+// drawRangePlot plots range against launch angle at the current power and
+// gravity, marking the current aim on the curve
+func (g *Game) drawRangePlot(screen *ebiten.Image) {
+	curve := rangeCurve(g.aimPower, g.gravity, rangePlotStep)
+	best := 0.0
+	for _, pt := range curve {
+		best = math.Max(best, pt.Y)
+	}
+	if best == 0 {
+		return
+	}
+
+	hudWidth := screenWidth / g.config.HUDScale
+	plot := LinePlot{
+		X: hudWidth - rangePlotOffsetX, Y: rangePlotY, W: rangePlotWidth, H: rangePlotHeight,
+		XMax: 90, YMax: best * 1.1,
+		Title: fmt.Sprintf("Range vs angle (max %.1f m)", best),
+	}
+	plot.Draw(screen, curve, color.RGBA{0, 200, 255, 255}, g.antialias)
+
+	_, r := flightTimeAndRange(g.launchAngle(), g.aimPower, g.gravity, 0)
+	aim := plot.toScreen(Vector2{g.launchAngle(), r})
+	fillCircle(screen, float32(aim.X), float32(aim.Y), 4, color.RGBA{255, 255, 0, 255}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, "0°", int(plot.X), int(plot.Y+plot.H)+2)
+	ebitenutil.DebugPrintAt(screen, "45°", int(plot.toScreen(Vector2{45, 0}).X)-8, int(plot.Y+plot.H)+2)
+	ebitenutil.DebugPrintAt(screen, "90°", int(plot.X+plot.W)-18, int(plot.Y+plot.H)+2)
+}
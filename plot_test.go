@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRangeCurvePeaksAt45(t *testing.T) {
+	tests := []struct {
+		power, gravity, step float64
+		points               int
+	}{
+		{12, defaultGravity, 1, 91},
+		{20, defaultGravity, 5, 19},
+		{8, 1.62, 2.5, 37},
+		{30, 24.8, 0.5, 181},
+	}
+	for _, tt := range tests {
+		curve := rangeCurve(tt.power, tt.gravity, tt.step)
+		if len(curve) != tt.points {
+			t.Fatalf("rangeCurve(%v, %v, %v) has %d points, want %d", tt.power, tt.gravity, tt.step, len(curve), tt.points)
+		}
+		best := curve[0]
+		for _, p := range curve {
+			if p.Y > best.Y {
+				best = p
+			}
+		}
+		if math.Abs(best.X-45) > tt.step/2 {
+			t.Errorf("power %v, gravity %v: range peaks at %v°, want 45°", tt.power, tt.gravity, best.X)
+		}
+		if want := tt.power * tt.power / tt.gravity; !approxEqual(best.Y, want, 1e-9) {
+			t.Errorf("power %v, gravity %v: peak range %v m, want v²/g = %v m", tt.power, tt.gravity, best.Y, want)
+		}
+		if first, last := curve[0], curve[len(curve)-1]; first.X != 0 || last.X != 90 || first.Y != 0 || last.Y > 1e-9 {
+			t.Errorf("power %v, gravity %v: curve runs from %v to %v, want 0° and 90° with no range", tt.power, tt.gravity, first, last)
+		}
+	}
+}
+
+func TestLinePlotToScreen(t *testing.T) {
+	p := LinePlot{X: 100, Y: 50, W: 200, H: 100, XMax: 90, YMax: 20}
+	tests := []struct {
+		d, want Vector2
+	}{
+		{Vector2{0, 0}, Vector2{100, 150}}, // origin at the bottom left
+		{Vector2{90, 20}, Vector2{300, 50}},
+		{Vector2{45, 10}, Vector2{200, 100}},
+	}
+	for _, tt := range tests {
+		if got := p.toScreen(tt.d); !approxEqual(got.X, tt.want.X, 1e-9) || !approxEqual(got.Y, tt.want.Y, 1e-9) {
+			t.Errorf("toScreen(%v) = %v, want %v", tt.d, got, tt.want)
+		}
+	}
+}
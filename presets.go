@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sort"
+)
+
+const presetsPath = "presets.json"
+
+// ShotPreset is a bookmarked shot: the aim and the conditions it was taken in
+type ShotPreset struct {
+	Angle   float64 `json:"angle"`
+	Power   float64 `json:"power"`
+	Gravity float64 `json:"gravity"`
+	Wind    float64 `json:"wind"`
+	Gusts   bool    `json:"gusts"`
+}
+
+// presetActions are the actions that recall the presets, in name order
+var presetActions = []Action{
+	ActionPreset1, ActionPreset2, ActionPreset3, ActionPreset4, ActionPreset5,
+	ActionPreset6, ActionPreset7, ActionPreset8, ActionPreset9,
+}
+
+// LoadPresets reads the presets file, returning no presets when it does not exist
+func LoadPresets(path string) (map[string]ShotPreset, error) {
+	presets := map[string]ShotPreset{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return presets, nil
+	}
+	if err != nil {
+		return presets, err
+	}
+	err = json.Unmarshal(data, &presets)
+	return presets, err
+}
+
+// SavePreset stores p under name in the presets file, replacing any preset of the same name
+func SavePreset(path, name string, p ShotPreset) error {
+	presets, err := LoadPresets(path)
+	if err != nil {
+		return err
+	}
+	presets[name] = p
+	data, err := json.MarshalIndent(presets, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// presetName labels a preset by its aim, so saving the same aim again overwrites it
+func presetName(p ShotPreset) string {
+	return fmt.Sprintf("%.0f° %.1f m/s", p.Angle, p.Power)
+}
+
+// presetNames returns the preset names in the order the number keys recall them
+func presetNames(presets map[string]ShotPreset) []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (g *Game) currentPreset() ShotPreset {
+	return ShotPreset{
+		Angle:   g.aimAngle,
+		Power:   g.aimPower,
+		Gravity: g.gravity,
+		Wind:    g.wind.Base,
+		Gusts:   g.wind.Gusts,
+	}
+}
+
+// savePreset stores the current shot in the presets file and the game's
+// presets, reporting whether it could
+func (g *Game) savePreset() bool {
+	p := g.currentPreset()
+	name := presetName(p)
+	if err := SavePreset(presetsPath, name, p); err != nil {
+		log.Printf("saving preset: %v", err)
+		g.flash("Could not save preset")
+		return false
+	}
+	g.presets[name] = p
+	g.flash("Saved preset " + name)
+	return true
+}
+
+// updatePresets saves the current shot with S and recalls presets with the number keys
+func (g *Game) updatePresets() {
+	if g.justPressed(ActionSavePreset) && !g.savePreset() {
+		return
+	}
+
+	if g.ball.Launched {
+		return
+	}
+	names := presetNames(g.presets)
+	for i, a := range presetActions {
+		if i < len(names) && g.justPressed(a) {
+			p := g.presets[names[i]]
+			g.aimAngle, g.aimPower, g.gravity = p.Angle, p.Power, p.Gravity
+			g.wind.Base, g.wind.Gusts = p.Wind, p.Gusts
+			g.flash("Loaded preset " + names[i])
+		}
+	}
+}
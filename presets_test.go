@@ -0,0 +1,86 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSaveAndLoadPresets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "presets.json")
+
+	presets, err := LoadPresets(path)
+	if err != nil || len(presets) != 0 {
+		t.Fatalf("LoadPresets with no file = %v, %v, want no presets and no error", presets, err)
+	}
+
+	lob := ShotPreset{Angle: 70, Power: 15, Gravity: 9.8}
+	drive := ShotPreset{Angle: 20, Power: 30, Gravity: 9.8, Wind: -1.5, Gusts: true}
+	steps := []struct {
+		name string
+		p    ShotPreset
+		want map[string]ShotPreset
+	}{
+		{"lob", lob, map[string]ShotPreset{"lob": lob}},
+		{"drive", drive, map[string]ShotPreset{"lob": lob, "drive": drive}},
+		{"lob", drive, map[string]ShotPreset{"lob": drive, "drive": drive}}, // overwrites
+	}
+	for _, s := range steps {
+		if err := SavePreset(path, s.name, s.p); err != nil {
+			t.Fatalf("SavePreset(%q): %v", s.name, err)
+		}
+		got, err := LoadPresets(path)
+		if err != nil {
+			t.Fatalf("LoadPresets after saving %q: %v", s.name, err)
+		}
+		if !reflect.DeepEqual(got, s.want) {
+			t.Errorf("after saving %q: presets = %+v, want %+v", s.name, got, s.want)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPresets(path); err == nil {
+		t.Errorf("LoadPresets of a corrupt file returned no error")
+	}
+}
+
+func TestPresetNames(t *testing.T) {
+	presets := map[string]ShotPreset{
+		presetName(ShotPreset{Angle: 60, Power: 12}):  {},
+		presetName(ShotPreset{Angle: 30, Power: 20}):  {},
+		presetName(ShotPreset{Angle: 45, Power: 9.5}): {},
+	}
+	want := []string{"30° 20.0 m/s", "45° 9.5 m/s", "60° 12.0 m/s"}
+	if got := presetNames(presets); !reflect.DeepEqual(got, want) {
+		t.Errorf("presetNames = %q, want %q", got, want)
+	}
+}
+
+func TestSavePresetOnFreshGame(t *testing.T) {
+	tests := []struct {
+		angle, power float64
+		want         int // presets after saving
+	}{
+		{45, 12, 1},
+		{60, 20, 2},
+		{45, 12, 2}, // the same shot again replaces the first
+	}
+	os.Remove(presetsPath)
+	defer os.Remove(presetsPath)
+	g := newTestGame()
+	for _, tt := range tests {
+		g.aimAngle, g.aimPower = tt.angle, tt.power
+		if !g.savePreset() {
+			t.Fatalf("saving %v° %v m/s failed", tt.angle, tt.power)
+		}
+		if got := g.presets[presetName(g.currentPreset())]; got != g.currentPreset() {
+			t.Errorf("saved %v° %v m/s, game holds %+v", tt.angle, tt.power, got)
+		}
+		if len(g.presets) != tt.want {
+			t.Errorf("after saving %v° %v m/s: %d presets, want %d", tt.angle, tt.power, len(g.presets), tt.want)
+		}
+	}
+}
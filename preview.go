@@ -0,0 +1,32 @@
+package main
+
+import "math"
+
+const (
+	previewStepDistance = 0.25       // meters a preview step may cover
+	minPreviewStep      = physicsDt  // seconds, the finest step however fast the shot, so a preview costs no more than the flight
+	maxPreviewStep      = 1.0 / 60.0 // seconds, the coarsest step for slow shots
+	previewDt           = 1.0 / 60.0 // seconds between the points of a traced preview path
+)
+
+// previewStep returns the integration step in seconds for a predicted path
+// moving at speed m/s. Each step covers about the same distance, so faster
+// shots take smaller steps and the curve stays smooth.
+func previewStep(speed float64) float64 {
+	if speed <= 0 {
+		return maxPreviewStep
+	}
+	return math.Max(minPreviewStep, math.Min(maxPreviewStep, previewStepDistance/speed))
+}
+
+// stepAdaptive advances a launch by dt seconds from t, splitting the interval
+// into steps no longer than previewStep allows at the current speed
+func stepAdaptive(params LaunchParams, pos, vel Vector2, t, dt float64) (Vector2, Vector2) {
+	for remaining := dt; remaining > 1e-9; {
+		h := math.Min(previewStep(vel.Magnitude()), remaining)
+		pos, vel = params.Step(pos, vel, t, h)
+		t += h
+		remaining -= h
+	}
+	return pos, vel
+}
@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestPreviewStepShrinksWithSpeed(t *testing.T) {
+	speeds := []float64{0, 5, 15, 30, 60}
+	prev := previewStep(speeds[0])
+	if prev != maxPreviewStep {
+		t.Errorf("previewStep(0) = %v, want %v", prev, maxPreviewStep)
+	}
+	for _, speed := range speeds[1:] {
+		step := previewStep(speed)
+		if step > prev {
+			t.Errorf("previewStep(%v) = %v, longer than %v at a slower speed", speed, step, prev)
+		}
+		if step < minPreviewStep || step > maxPreviewStep {
+			t.Errorf("previewStep(%v) = %v, want within [%v, %v]", speed, step, minPreviewStep, maxPreviewStep)
+		}
+		prev = step
+	}
+	if previewStep(30) >= previewStep(5) {
+		t.Errorf("previewStep(30) = %v, want smaller than previewStep(5) = %v", previewStep(30), previewStep(5))
+	}
+}
+
+func TestPreviewEndsAtGround(t *testing.T) {
+	tests := []struct {
+		angle, power float64
+	}{
+		{30, 8},
+		{45, 12},
+		{70, 20},
+		{10, 25},
+	}
+	for _, tt := range tests {
+		params := vacuumParams(tt.angle, tt.power)
+		points, hit := traceTrajectory(params, nil, nil, Walls{}, nil, previewDt, 10)
+		if hit.Kind != HitGround {
+			t.Errorf("preview(%v°, %v m/s) stopped with %v, want HitGround", tt.angle, tt.power, hit.Kind)
+			continue
+		}
+		last := points[len(points)-1]
+		want := Terrain(nil).ContactY(last.X, params.Projectile.Radius)
+		if !approxEqual(last.Y, want, 1e-6) {
+			t.Errorf("preview(%v°, %v m/s) ends at y = %v, want %v", tt.angle, tt.power, last.Y, want)
+		}
+	}
+}
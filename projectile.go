@@ -0,0 +1,48 @@
+package main
+
+import (
+	"image/color"
+	"math"
+)
+
+// Projectile is a selectable ball type. DragCoeff is the dimensionless drag
+// coefficient Cd; the cross-section comes from the radius.
+type Projectile struct {
+	Name      string
+	Mass      float64 // kg
+	Radius    float64 // pixels
+	DragCoeff float64
+	Color     color.RGBA
+}
+
+// projectiles are the presets cycled through in game. The first one flies in a vacuum.
+var projectiles = []Projectile{
+	{Name: "Standard", Mass: 1, Radius: 8, DragCoeff: 0, Color: color.RGBA{255, 100, 100, 255}},
+	{Name: "Cannonball", Mass: 5, Radius: 7, DragCoeff: 0.47, Color: color.RGBA{40, 40, 40, 255}},
+	{Name: "Beach ball", Mass: 0.5, Radius: 14, DragCoeff: 0.47, Color: color.RGBA{255, 220, 60, 255}},
+	{Name: "Bullet", Mass: 0.05, Radius: 4, DragCoeff: 0.04, Color: color.RGBA{200, 170, 60, 255}},
+}
+
+// Area returns the projectile's cross-sectional area in m² at scale pixels per meter
+func (p Projectile) Area(scale float64) float64 {
+	r := p.Radius / scale
+	return math.Pi * r * r
+}
+
+const (
+	minBallRadius  = 3.0  // pixels
+	maxBallRadius  = 20.0 // pixels
+	ballRadiusStep = 1.0
+)
+
+// currentProjectile is the selected projectile type at the ball's chosen size
+func (g *Game) currentProjectile() Projectile {
+	p := projectiles[g.projectile]
+	p.Radius = g.ball.Radius
+	return p
+}
+
+// nextProjectile cycles through the projectile presets
+func nextProjectile(index int) int {
+	return (index + 1) % len(projectiles)
+}
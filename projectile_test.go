@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestProjectilePresetLaunch(t *testing.T) {
+	g := newTestGame()
+	for i := range projectiles {
+		if g.projectile != i {
+			t.Fatalf("after %d presses the projectile is %d, want %d", i, g.projectile, i)
+		}
+		want := projectiles[i]
+		g.launch()
+		b := g.ball
+		if b.Radius != want.Radius || b.Color != want.Color || b.Params.Projectile != want {
+			t.Errorf("%s: ball radius %v, color %v, projectile %+v, want radius %v, color %v, projectile %+v",
+				want.Name, b.Radius, b.Color, b.Params.Projectile, want.Radius, want.Color, want)
+		}
+		g.ResetBall()
+		g.applyAction(ActionProjectile)
+	}
+	if g.projectile != 0 {
+		t.Errorf("cycling through every preset ends on %d, want back at 0", g.projectile)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// ShotSample is the recorded ball state at one moment of a shot
+type ShotSample struct {
+	T   float64
+	Pos Vector2
+	Vel Vector2
+}
+
+func (b *Ball) sample() ShotSample {
+	return ShotSample{T: b.Time, Pos: b.Position, Vel: b.Velocity}
+}
+
+// sampleAt linearly interpolates the recorded samples at time t, clamping to the first and last sample
+func sampleAt(samples []ShotSample, t float64) ShotSample {
+	if len(samples) == 0 {
+		return ShotSample{}
+	}
+	if t <= samples[0].T {
+		return samples[0]
+	}
+	for i := 1; i < len(samples); i++ {
+		if t <= samples[i].T {
+			a, b := samples[i-1], samples[i]
+			f := (t - a.T) / (b.T - a.T)
+			return ShotSample{
+				T:   t,
+				Pos: a.Pos.Add(b.Pos.Add(a.Pos.Scale(-1)).Scale(f)),
+				Vel: a.Vel.Add(b.Vel.Add(a.Vel.Scale(-1)).Scale(f)),
+			}
+		}
+	}
+	return samples[len(samples)-1]
+}
+
+// apexSample returns the highest recorded sample
+func apexSample(samples []ShotSample) ShotSample {
+	apex := samples[0]
+	for _, s := range samples {
+		if s.Pos.Y < apex.Pos.Y {
+			apex = s
+		}
+	}
+	return apex
+}
+
+// replayEnergies returns the energy in joules of the last shot's ball at
+// sample s, measured like the in-flight HUD with potential energy from the
+// launch height
+func (g *Game) replayEnergies(s ShotSample) (ke, pe float64) {
+	p := g.lastParams
+	return bodyEnergies(p.Projectile.Mass, s.Pos, s.Vel, p.Gravity, p.Start.Y, p.Scale)
+}
+
+// startReplay plays back the last completed shot while the ball is not in flight
+func (g *Game) startReplay() {
+	if len(g.lastShot) < 2 || (g.ball.Launched && !g.ball.Landed) {
+		return
+	}
+	g.replaying = true
+	g.replayTime = 0
+}
+
+func (g *Game) updateReplay(dt float64) {
+	if !g.replaying {
+		return
+	}
+	g.replayTime += dt
+	if g.replayTime > g.lastShot[len(g.lastShot)-1].T {
+		g.replaying = false
+	}
+}
+
+// replayState is the replayed ball state that the replay ball and overlays are drawn from
+func (g *Game) replayState() ShotSample {
+	return sampleAt(g.lastShot, g.replayTime)
+}
+
+func (g *Game) drawReplay(screen *ebiten.Image) {
+	state := g.replayState()
+	fillCircle(screen, float32(state.Pos.X), float32(state.Pos.Y), float32(g.ball.Radius),
+		color.RGBA{120, 160, 255, 200}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("REPLAY %.2f s", state.T), int(state.Pos.X)-30, int(state.Pos.Y)-30)
+
+	if !g.replayOverlay {
+		return
+	}
+
+	g.drawVelocityVector(screen, state.Pos, state.Vel)
+
+	ebitenutil.DebugPrintAt(screen, energyText(g.replayEnergies(state)),
+		int(state.Pos.X)+12, int(state.Pos.Y)+4)
+
+	// The apex marker appears once the replay has passed the highest point
+	apex := apexSample(g.lastShot)
+	if state.T >= apex.T {
+		strokeCircle(screen, float32(apex.Pos.X), float32(apex.Pos.Y), 6, 2, color.RGBA{255, 0, 255, 255}, g.antialias)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("apex %.1f m", g.heightAboveGround(apex.Pos)), int(apex.Pos.X)-25, int(apex.Pos.Y)-24)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import "testing"
+
+func TestReplayState(t *testing.T) {
+	g := newTestGame()
+	g.lastShot = []ShotSample{
+		{T: 0, Pos: Vector2{100, 600}, Vel: Vector2{10, 10}},
+		{T: 1, Pos: Vector2{200, 500}, Vel: Vector2{10, 0}},
+		{T: 2, Pos: Vector2{300, 600}, Vel: Vector2{10, -10}},
+	}
+	tests := []struct {
+		time float64
+		want ShotSample
+	}{
+		{-1, g.lastShot[0]},
+		{0, g.lastShot[0]},
+		{0.5, ShotSample{T: 0.5, Pos: Vector2{150, 550}, Vel: Vector2{10, 5}}},
+		{1, g.lastShot[1]},
+		{1.5, ShotSample{T: 1.5, Pos: Vector2{250, 550}, Vel: Vector2{10, -5}}},
+		{5, g.lastShot[2]},
+	}
+	for _, tt := range tests {
+		g.replayTime = tt.time
+		if got := g.replayState(); got != tt.want {
+			t.Errorf("replayState at %v s = %+v, want %+v", tt.time, got, tt.want)
+		}
+	}
+	if apex := apexSample(g.lastShot); apex != g.lastShot[1] {
+		t.Errorf("apexSample = %+v, want %+v", apex, g.lastShot[1])
+	}
+}
+
+func TestReplayEnergyMatchesFlight(t *testing.T) {
+	tests := []struct {
+		projectile int
+		height     float64 // platform meters
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 4},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles = nil, nil
+		g.projectile = tt.projectile
+		g.setPlatformHeight(tt.height)
+		g.launch()
+		type reading struct {
+			s      ShotSample
+			ke, pe float64
+		}
+		var flight []reading
+		for i := 0; i < 10/physicsDt && !g.ball.Landed; i++ {
+			g.step(physicsDt)
+			ke, pe := energies(g.ball, g.gravity, g.ball.Params.Start.Y, g.scale)
+			flight = append(flight, reading{g.ball.sample(), ke, pe})
+		}
+		g.projectile, g.gravity = 0, 1.6 // changing settings after the shot leaves the replay alone
+		for _, r := range flight {
+			ke, pe := g.replayEnergies(r.s)
+			if !approxEqual(ke, r.ke, 1e-9) || !approxEqual(pe, r.pe, 1e-9) {
+				t.Fatalf("%s from %v m at %.3f s: replay energy %v J + %v J, in flight %v J + %v J", projectiles[tt.projectile].Name, tt.height, r.s.T, ke, pe, r.ke, r.pe)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const reticleSnapRadius = 40.0 // pixels from a target center at which the reticle snaps to it
+
+// nearestTarget returns the index of the target closest to cursor, if one lies within threshold pixels
+func (g *Game) nearestTarget(cursor Vector2, threshold float64) (int, bool) {
+	best, bestDist := -1, threshold
+	for i, t := range g.targets {
+		d := t.Position.Add(cursor.Scale(-1)).Magnitude()
+		if d <= bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best, best >= 0
+}
+
+// cursorWorld returns the mouse position in world space
+func (g *Game) cursorWorld() Vector2 {
+	x, y := ebiten.CursorPosition()
+	return g.camera.ScreenToWorld(Vector2{float64(x), float64(y)})
+}
+
+// updateReticle makes a plain click on a snapped target the active target
+func (g *Game) updateReticle() {
+	if ebiten.IsKeyPressed(ebiten.KeyShift) || ebiten.IsKeyPressed(ebiten.KeyControl) || !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return
+	}
+	if i, ok := g.nearestTarget(g.cursorWorld(), reticleSnapRadius); ok {
+		g.activeTarget = i
+	}
+}
+
+// drawReticle draws a crosshair at the cursor, snapping it onto a nearby target and ringing that target
+func (g *Game) drawReticle(screen *ebiten.Image) {
+	p := g.cursorWorld()
+	reticleColor := color.RGBA{255, 255, 255, 200}
+	if i, ok := g.nearestTarget(p, reticleSnapRadius); ok {
+		p = g.targets[i].Position
+		reticleColor = color.RGBA{0, 255, 0, 230}
+		strokeCircle(screen, float32(p.X), float32(p.Y), float32(g.targets[i].HitRadius()+g.ball.Radius), 2, reticleColor, g.antialias)
+	}
+
+	x, y := float32(p.X), float32(p.Y)
+	strokeCircle(screen, x, y, 10, 1.5, reticleColor, g.antialias)
+	strokeLine(screen, x-16, y, x-4, y, 1.5, reticleColor, g.antialias)
+	strokeLine(screen, x+4, y, x+16, y, 1.5, reticleColor, g.antialias)
+	strokeLine(screen, x, y-16, x, y-4, 1.5, reticleColor, g.antialias)
+	strokeLine(screen, x, y+4, x, y+16, 1.5, reticleColor, g.antialias)
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestNearestTarget(t *testing.T) {
+	g := newTestGame()
+	g.targets = []Target{NewTarget(500, 300, 1), NewTarget(600, 300, 1), NewTarget(500, 450, 1)}
+	tests := []struct {
+		cursor    Vector2
+		threshold float64
+		want      int
+		wantOK    bool
+	}{
+		{Vector2{500, 300}, 40, 0, true},   // dead center
+		{Vector2{530, 300}, 40, 0, true},   // off center but inside
+		{Vector2{560, 300}, 40, 1, true},   // closer to the second target
+		{Vector2{500, 420}, 40, 2, true},   // the lower target
+		{Vector2{520, 360}, 40, -1, false}, // between them, out of reach
+		{Vector2{520, 360}, 100, 0, true},  // a wider threshold reaches the first
+		{Vector2{540, 300}, 40, 0, true},   // exactly at the threshold still snaps
+		{Vector2{900, 100}, 40, -1, false}, // far from every target
+	}
+	for _, tt := range tests {
+		got, ok := g.nearestTarget(tt.cursor, tt.threshold)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("nearestTarget(%v, %v) = %d, %v, want %d, %v", tt.cursor, tt.threshold, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	g.targets = nil
+	if i, ok := g.nearestTarget(Vector2{500, 300}, 40); ok {
+		t.Errorf("nearestTarget with no targets = %d, true, want false", i)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import "math"
+
+// Rocket describes a projectile that burns fuel at a constant rate after
+// launch. As the mass drops the same thrust gives a growing acceleration.
+type Rocket struct {
+	Thrust   float64 // N
+	DryMass  float64 // kg without fuel
+	FuelMass float64 // kg of fuel at launch
+	BurnTime float64 // s to burn all fuel
+}
+
+var defaultRocket = Rocket{Thrust: 10, DryMass: 1, FuelMass: 1, BurnTime: 1}
+
+// MassAt returns the rocket's mass t seconds after launch
+func (r Rocket) MassAt(t float64) float64 {
+	burnt := math.Max(0, math.Min(1, t/r.BurnTime))
+	return r.DryMass + r.FuelMass*(1-burnt)
+}
+
+// AccelAt returns the thrust acceleration in m/s² t seconds after launch, zero once the fuel is gone
+func (r Rocket) AccelAt(t float64) float64 {
+	if t < 0 || t >= r.BurnTime {
+		return 0
+	}
+	return r.Thrust / r.MassAt(t)
+}
+
+// Burning reports whether the engine is still firing at time t
+func (r Rocket) Burning(t float64) bool {
+	return t >= 0 && t < r.BurnTime
+}
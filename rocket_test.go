@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestRocketBurn(t *testing.T) {
+	r := defaultRocket
+	tests := []struct {
+		t     float64
+		mass  float64
+		accel float64
+	}{
+		{0, 2, 5},
+		{0.25, 1.75, 10 / 1.75},
+		{0.5, 1.5, 10 / 1.5},
+		{0.75, 1.25, 8},
+		{1, 1, 0}, // burnt out
+		{3, 1, 0},
+	}
+	prev := 0.0
+	for _, tt := range tests {
+		mass, accel := r.MassAt(tt.t), r.AccelAt(tt.t)
+		if !approxEqual(mass, tt.mass, 1e-9) || !approxEqual(accel, tt.accel, 1e-9) {
+			t.Errorf("at %v s: mass %v kg, accel %v m/s², want %v kg, %v m/s²", tt.t, mass, accel, tt.mass, tt.accel)
+		}
+		if r.Burning(tt.t) {
+			if accel <= prev {
+				t.Errorf("at %v s: accel %v m/s² did not grow from %v m/s²", tt.t, accel, prev)
+			}
+			prev = accel
+		}
+	}
+}
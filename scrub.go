@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const scrubRepeatDelay = 15 // ticks a held arrow waits before stepping repeatedly
+
+// clampScrub keeps a scrub position inside [0, count), returning 0 when there are no samples
+func clampScrub(index, count int) int {
+	return clampTargetIndex(index, count)
+}
+
+// scrubSample returns the recorded sample at a scrub position, clamped to the recorded range
+func scrubSample(samples []ShotSample, index int) ShotSample {
+	if len(samples) == 0 {
+		return ShotSample{}
+	}
+	return samples[clampScrub(index, len(samples))]
+}
+
+// scrubKeyStep reports whether key steps the scrubber this tick: once when
+// pressed, then every tick after it has been held for scrubRepeatDelay
+func scrubKeyStep(key ebiten.Key) bool {
+	d := inpututil.KeyPressDuration(key)
+	return d == 1 || d > scrubRepeatDelay
+}
+
+// updateScrub steps through the last shot one sample at a time with the arrows while paused
+func (g *Game) updateScrub() {
+	if !g.paused || len(g.lastShot) == 0 || (g.ball.Launched && !g.ball.Landed) {
+		g.scrubbing = false
+		return
+	}
+
+	step := 0
+	if scrubKeyStep(ebiten.KeyArrowRight) {
+		step++
+	}
+	if scrubKeyStep(ebiten.KeyArrowLeft) {
+		step--
+	}
+	if step == 0 {
+		return
+	}
+
+	// Scrubbing starts from the end of the shot
+	if !g.scrubbing {
+		g.scrubbing = true
+		g.scrubIndex = len(g.lastShot) - 1
+	}
+	g.scrubIndex = clampScrub(g.scrubIndex+step, len(g.lastShot))
+}
+
+func (g *Game) drawScrub(screen *ebiten.Image) {
+	s := scrubSample(g.lastShot, g.scrubIndex)
+	fillCircle(screen, float32(s.Pos.X), float32(s.Pos.Y), float32(g.ball.Radius), color.RGBA{255, 255, 255, 220}, g.antialias)
+
+	label := fmt.Sprintf("%d/%d  t %.3f s\nv %.1f m/s  h %.1f m",
+		g.scrubIndex+1, len(g.lastShot), s.T, s.Vel.Magnitude(), g.heightAboveGround(s.Pos))
+	ebitenutil.DebugPrintAt(screen, label, int(s.Pos.X)+12, int(s.Pos.Y)-30)
+}
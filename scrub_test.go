@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestScrubSample(t *testing.T) {
+	samples := make([]ShotSample, 5)
+	for i := range samples {
+		samples[i] = ShotSample{T: float64(i) * 0.1, Pos: Vector2{float64(i * 10), 0}}
+	}
+	tests := []struct {
+		index, want int
+	}{
+		{0, 0},
+		{3, 3},
+		{4, 4},
+		{5, 4}, // past the end stops on the last sample
+		{99, 4},
+		{-1, 0}, // before the start stops on the first
+	}
+	for _, tt := range tests {
+		if got := clampScrub(tt.index, len(samples)); got != tt.want {
+			t.Errorf("clampScrub(%d, %d) = %d, want %d", tt.index, len(samples), got, tt.want)
+		}
+		if got := scrubSample(samples, tt.index); got != samples[tt.want] {
+			t.Errorf("scrubSample at %d = %v, want %v", tt.index, got, samples[tt.want])
+		}
+	}
+
+	if got := clampScrub(3, 0); got != 0 {
+		t.Errorf("clampScrub(3, 0) = %d, want 0", got)
+	}
+	if got := scrubSample(nil, 2); got != (ShotSample{}) {
+		t.Errorf("scrubSample with no samples = %v, want the zero sample", got)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"time"
+)
+
+// Session summarizes one game for export, e.g. for a teacher collecting results
+type Session struct {
+	Attempts  int            `json:"attempts"`
+	Hits      int            `json:"hits"`
+	Score     int            `json:"score"`
+	BestRange float64        `json:"best_range_m"`
+	Shots     []ShotLogEntry `json:"shots"`
+}
+
+// WriteSessionSummary writes the session to w as indented JSON
+func WriteSessionSummary(w io.Writer, s Session) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// session collects the current game's results. Unlike the shot log panel it
+// includes every shot taken.
+func (g *Game) session() Session {
+	s := Session{Attempts: g.attempts, Score: g.score, Shots: g.sessionShots}
+	if s.Shots == nil {
+		s.Shots = []ShotLogEntry{}
+	}
+	for _, shot := range g.sessionShots {
+		if shot.Hit {
+			s.Hits++
+		}
+		s.BestRange = math.Max(s.BestRange, shot.Range)
+	}
+	return s
+}
+
+// exportSession saves the session summary to a timestamped JSON file
+func (g *Game) exportSession() {
+	path := fmt.Sprintf("session_%s.json", time.Now().Format("20060102_150405"))
+	f, err := os.Create(path)
+	if err != nil {
+		log.Printf("exporting session: %v", err)
+		g.flash("Could not export the session")
+		return
+	}
+	defer f.Close()
+	if err := WriteSessionSummary(f, g.session()); err != nil {
+		log.Printf("exporting session: %v", err)
+		g.flash("Could not export the session")
+		return
+	}
+	g.flash("Saved " + path)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestWriteSessionSummary(t *testing.T) {
+	shots := []ShotLogEntry{
+		{Attempt: 1, Angle: 40, Power: 12, Range: 14.2},
+		{Attempt: 2, Angle: 45, Power: 12, Range: 14.7, Hit: true},
+		{Attempt: 3, Angle: 60, Power: 15, Range: 19.9, Hit: true},
+	}
+	tests := []struct {
+		name  string
+		shots []ShotLogEntry
+		score int
+		want  Session
+	}{
+		{"no shots", nil, 0, Session{Shots: []ShotLogEntry{}}},
+		{"mixed shots", shots, 20, Session{Attempts: 3, Hits: 2, Score: 20, BestRange: 19.9, Shots: shots}},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.sessionShots, g.attempts, g.score = tt.shots, len(tt.shots), tt.score
+
+		var buf bytes.Buffer
+		if err := WriteSessionSummary(&buf, g.session()); err != nil {
+			t.Fatalf("%s: WriteSessionSummary: %v", tt.name, err)
+		}
+		if !json.Valid(buf.Bytes()) {
+			t.Fatalf("%s: wrote invalid JSON: %s", tt.name, buf.String())
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var keys []string
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		if want := []string{"attempts", "best_range_m", "hits", "score", "shots"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("%s: fields %v, want %v", tt.name, keys, want)
+		}
+		if string(fields["shots"]) == "null" {
+			t.Errorf("%s: shots written as null, want a list", tt.name)
+		}
+
+		var got Session
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: summary = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
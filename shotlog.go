@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const (
+	maxShotLog     = 50 // entries kept
+	shotLogRows    = 10 // entries visible at once
+	shotLogWidth   = 230
+	shotLogOffsetX = 240 // from the right edge of the HUD
+	shotLogY       = 330
+)
+
+// ShotLogEntry records the aim and result of one finished shot
+type ShotLogEntry struct {
+	Attempt int     `json:"attempt"`
+	Angle   float64 `json:"angle_deg"`
+	Power   float64 `json:"power_mps"`
+	Range   float64 `json:"range_m"` // from the cannon to where the ball stopped
+	Hit     bool    `json:"hit"`
+}
+
+func formatShotLogEntry(e ShotLogEntry) string {
+	result := "miss"
+	if e.Hit {
+		result = "HIT"
+	}
+	return fmt.Sprintf("#%-3d %4.1f° %4.1f m/s %5.1f m %s", e.Attempt, e.Angle, e.Power, e.Range, result)
+}
+
+// logShot appends the finished shot to the log, dropping the oldest entry
+// when it is full, and to the session's complete record
+func (g *Game) logShot(hit bool) {
+	entry := ShotLogEntry{
+		Attempt: g.attempts,
+		Angle:   g.ball.Params.Angle,
+		Power:   g.ball.Params.Power,
+		Range:   (g.ball.Position.X - g.ball.Params.Start.X) / g.scale,
+		Hit:     hit,
+	}
+	g.sessionShots = append(g.sessionShots, entry)
+	g.shotLog = append(g.shotLog, entry)
+	if len(g.shotLog) > maxShotLog {
+		g.shotLog = g.shotLog[len(g.shotLog)-maxShotLog:]
+	}
+	g.shotLogScroll = 0
+}
+
+// shotLogPanel returns the log panel's rectangle in HUD pixels
+func (g *Game) shotLogPanel() (x, y, w, h float64) {
+	hudWidth := screenWidth / g.config.HUDScale
+	return hudWidth - shotLogOffsetX, shotLogY, shotLogWidth, float64((shotLogRows+1)*panelLineHeight + 10)
+}
+
+// updateShotLog scrolls the log with the mouse wheel while the cursor is over it
+func (g *Game) updateShotLog() {
+	_, dy := ebiten.Wheel()
+	if dy == 0 || g.cinematic {
+		return
+	}
+	cx, cy := ebiten.CursorPosition()
+	mx, my := float64(cx)/g.config.HUDScale, float64(cy)/g.config.HUDScale
+	x, y, w, h := g.shotLogPanel()
+	if mx < x || mx >= x+w || my < y || my >= y+h {
+		return
+	}
+
+	maxScroll := math.Max(0, float64(len(g.shotLog)-shotLogRows))
+	g.shotLogScroll = int(math.Max(0, math.Min(maxScroll, float64(g.shotLogScroll)+math.Copysign(1, dy))))
+}
+
+// drawShotLog lists the most recent shots newest first, offset by the scroll position
+func (g *Game) drawShotLog(screen *ebiten.Image) {
+	if len(g.shotLog) == 0 {
+		return
+	}
+	x, y, w, h := g.shotLogPanel()
+	fillRect(screen, float32(x), float32(y), float32(w), float32(h), color.RGBA{0, 0, 0, 128}, g.antialias)
+	ebitenutil.DebugPrintAt(screen, "Shot log (wheel scrolls)", int(x)+8, int(y)+5)
+
+	for row := 0; row < shotLogRows; row++ {
+		i := len(g.shotLog) - 1 - g.shotLogScroll - row
+		if i < 0 {
+			break
+		}
+		ebitenutil.DebugPrintAt(screen, formatShotLogEntry(g.shotLog[i]), int(x)+8, int(y)+5+(row+1)*panelLineHeight)
+	}
+}
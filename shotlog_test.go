@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestFormatShotLogEntry(t *testing.T) {
+	tests := []struct {
+		e    ShotLogEntry
+		want string
+	}{
+		{ShotLogEntry{Attempt: 1, Angle: 45, Power: 12, Range: 14.7, Hit: true}, "#1   45.0° 12.0 m/s  14.7 m HIT"},
+		{ShotLogEntry{Attempt: 7, Angle: 30.25, Power: 8.5, Range: 6.04}, "#7   30.2°  8.5 m/s   6.0 m miss"},
+		{ShotLogEntry{Attempt: 123, Angle: 5, Power: 25, Range: 103.26, Hit: true}, "#123  5.0° 25.0 m/s 103.3 m HIT"},
+	}
+	for _, tt := range tests {
+		if got := formatShotLogEntry(tt.e); got != tt.want {
+			t.Errorf("formatShotLogEntry(%+v) = %q, want %q", tt.e, got, tt.want)
+		}
+	}
+}
+
+func TestLogShotKeepsRecent(t *testing.T) {
+	g := newTestGame()
+	for i := 1; i <= maxShotLog+3; i++ {
+		g.attempts = i
+		g.logShot(i%2 == 0)
+	}
+	if len(g.shotLog) != maxShotLog {
+		t.Fatalf("log holds %d entries, want %d", len(g.shotLog), maxShotLog)
+	}
+	if first := g.shotLog[0].Attempt; first != 4 {
+		t.Errorf("oldest logged attempt = %d, want 4", first)
+	}
+	if len(g.sessionShots) != maxShotLog+3 {
+		t.Errorf("session record holds %d shots, want %d", len(g.sessionShots), maxShotLog+3)
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	sliderRowHeight = 20
+	sliderTrackX    = 150 // HUD pixels from the left edge of the screen
+	sliderTrackW    = 150
+	sliderGrab      = 8 // pixels above or below the track that still grab it
+)
+
+// Slider is a draggable control bound to a value. Its track is laid out in
+// HUD pixels, before the HUD scale is applied.
+type Slider struct {
+	X, Y, Width float64
+	Min, Max    float64
+	Step        float64 // values snap to multiples of Step, 0 for no snapping
+	Value       *float64
+	Format      string // label with a verb for the value
+}
+
+// valueFromMouseX maps a mouse x onto the track to a value, clamped to the
+// slider's range and snapped to its step
+func (s Slider) valueFromMouseX(x float64) float64 {
+	k := math.Max(0, math.Min(1, (x-s.X)/s.Width))
+	v := s.Min + k*(s.Max-s.Min)
+	if s.Step > 0 {
+		v = math.Round(v/s.Step) * s.Step
+	}
+	return math.Max(s.Min, math.Min(s.Max, v))
+}
+
+// Contains reports whether the HUD point (x, y) is on or near the track
+func (s Slider) Contains(x, y float64) bool {
+	return x >= s.X-sliderGrab && x <= s.X+s.Width+sliderGrab && math.Abs(y-s.Y) <= sliderGrab
+}
+
+func (s Slider) Draw(screen *ebiten.Image, active, antialias bool) {
+	k := (*s.Value - s.Min) / (s.Max - s.Min)
+	knobX := float32(s.X + k*s.Width)
+	x, y, w := float32(s.X), float32(s.Y), float32(s.Width)
+
+	strokeLine(screen, x, y, x+w, y, 4, color.RGBA{80, 80, 80, 255}, antialias)
+	strokeLine(screen, x, y, knobX, y, 4, color.RGBA{255, 255, 0, 255}, antialias)
+	knobColor := color.RGBA{230, 230, 230, 255}
+	if active {
+		knobColor = color.RGBA{255, 255, 0, 255}
+	}
+	fillCircle(screen, knobX, y, 6, knobColor, antialias)
+
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf(s.Format, *s.Value), 20, int(s.Y)-8)
+}
+
+// sliders lays out the parameter sliders at the top of the UI panel
+func (g *Game) sliders() []Slider {
+	sliders := []Slider{
+		{Min: minAimAngle, Max: maxAimAngle, Step: 0.5, Value: &g.aimAngle, Format: "Angle: %.1f°"},
+		{Min: minPower, Max: maxPower, Step: 0.5, Value: &g.aimPower, Format: "Power: %.1f m/s"},
+		{Min: 1, Max: 25, Step: 0.1, Value: &g.gravity, Format: "Gravity: %.1f m/s²"},
+		{Min: -maxWind, Max: maxWind, Step: windStep, Value: &g.wind.Base, Format: "Base wind: %+.1f m/s²"},
+		{Min: 0.1, Max: 2, Step: 0.1, Value: &g.timeScale, Format: "Time scale: x%.1f"},
+	}
+	for i := range sliders {
+		sliders[i].X = sliderTrackX
+		sliders[i].Y = float64(28 + i*sliderRowHeight)
+		sliders[i].Width = sliderTrackW
+	}
+	return sliders
+}
+
+// updateSliders drags the slider grabbed with the mouse. It reports whether
+// it is using the mouse this frame, so other click handlers can stand down.
+func (g *Game) updateSliders() bool {
+	if g.cinematic || !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		g.dragSlider = -1
+		return false
+	}
+
+	cx, cy := ebiten.CursorPosition()
+	x, y := float64(cx)/g.config.HUDScale, float64(cy)/g.config.HUDScale
+	sliders := g.sliders()
+	if g.dragSlider < 0 && inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		for i, s := range sliders {
+			if s.Contains(x, y) {
+				g.dragSlider = i
+			}
+		}
+	}
+	if g.dragSlider < 0 {
+		return false
+	}
+
+	s := sliders[g.dragSlider]
+	*s.Value = s.valueFromMouseX(x)
+	return true
+}
@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestSliderValueFromMouseX(t *testing.T) {
+	angle := Slider{X: 100, Width: 200, Min: 0, Max: 90}
+	power := Slider{X: 100, Width: 200, Min: 5, Max: 30, Step: 0.5}
+	tests := []struct {
+		s    Slider
+		x    float64
+		want float64
+	}{
+		{angle, 100, 0},
+		{angle, 300, 90},
+		{angle, 200, 45},
+		{angle, 150, 22.5},
+		{angle, 40, 0},   // left of the track clamps to the minimum
+		{angle, 999, 90}, // right of it to the maximum
+		{power, 100, 5},
+		{power, 200, 17.5},
+		{power, 203, 18}, // 17.875 snaps to the nearest half
+		{power, 300, 30},
+	}
+	for _, tt := range tests {
+		if got := tt.s.valueFromMouseX(tt.x); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("slider %v-%v step %v: valueFromMouseX(%v) = %v, want %v", tt.s.Min, tt.s.Max, tt.s.Step, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestSliderContains(t *testing.T) {
+	s := Slider{X: 100, Y: 50, Width: 200}
+	tests := []struct {
+		x, y float64
+		want bool
+	}{
+		{200, 50, true},
+		{100 - sliderGrab, 50 + sliderGrab, true},
+		{300 + sliderGrab + 1, 50, false},
+		{200, 50 - sliderGrab - 1, false},
+	}
+	for _, tt := range tests {
+		if got := s.Contains(tt.x, tt.y); got != tt.want {
+			t.Errorf("Contains(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
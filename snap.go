@@ -0,0 +1,51 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// snapThreshold is how close in degrees the aim must come to a common angle
+// to snap onto it. Keyboard aim moves 1° a frame, so with a strict 1° the aim
+// snaps on the way past a common angle without sticking to it.
+const snapThreshold = 1.0
+
+// snapTolerance is how close in degrees the launch angle must be to a common
+// angle for its tick to light up, allowing for steps that don't add up exactly
+const snapTolerance = 1e-6
+
+// commonAngles are the textbook launch angles the aim snaps to
+var commonAngles = []float64{0, 15, 30, 45, 60, 75, 90}
+
+// snapAngle returns the snap angle nearest to angle if it lies less than
+// threshold degrees away, and angle unchanged otherwise
+func snapAngle(angle, threshold float64, snaps []float64) float64 {
+	best, bestDist := angle, threshold
+	for _, s := range snaps {
+		if d := math.Abs(angle - s); d < bestDist {
+			best, bestDist = s, d
+		}
+	}
+	return best
+}
+
+// snappedTo reports whether the shot would launch at the snap angle a
+func (g *Game) snappedTo(a float64) bool {
+	return math.Abs(g.launchAngle()-a) < snapTolerance
+}
+
+// drawSnapTicks marks the common angles just outside the aim gauge's arc,
+// brightening the one the launch angle is on
+func (g *Game) drawSnapTicks(screen *ebiten.Image, cx, cy, radius float32) {
+	for _, a := range commonAngles {
+		rad := a * math.Pi / 180.0
+		cos, sin := float32(math.Cos(rad)), float32(math.Sin(rad))
+		tickColor, length := color.RGBA{255, 255, 255, 120}, float32(4)
+		if g.snappedTo(a) {
+			tickColor, length = color.RGBA{0, 255, 120, 255}, 9
+		}
+		strokeLine(screen, cx+radius*cos, cy-radius*sin, cx+(radius+length)*cos, cy-(radius+length)*sin, 2, tickColor, g.antialias)
+	}
+}
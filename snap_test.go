@@ -0,0 +1,60 @@
+package main
+
+import "testing"
+
+func TestSnapAngle(t *testing.T) {
+	tests := []struct {
+		angle, want float64
+	}{
+		{44.5, 45},
+		{45.9, 45},
+		{45, 45},
+		{0.3, 0},
+		{89.2, 90},
+		{29.01, 30},
+		{44, 44},     // exactly the threshold away stays put
+		{46.5, 46.5}, // too far from 45
+		{37.5, 37.5}, // between snaps
+		{52, 52},
+		{-0.5, 0},
+	}
+	for _, tt := range tests {
+		if got := snapAngle(tt.angle, snapThreshold, commonAngles); got != tt.want {
+			t.Errorf("snapAngle(%v) = %v, want %v", tt.angle, got, tt.want)
+		}
+	}
+
+	// The nearest of two snaps within reach wins
+	if got := snapAngle(21, 5, []float64{15, 20, 25}); got != 20 {
+		t.Errorf("snapAngle(21) between 20 and 25 = %v, want 20", got)
+	}
+	if got := snapAngle(30.5, snapThreshold, nil); got != 30.5 {
+		t.Errorf("snapAngle(30.5) with no snaps = %v, want 30.5", got)
+	}
+}
+
+func TestSnappedTo(t *testing.T) {
+	stepped := 0.0
+	for i := 0; i < 450; i++ {
+		stepped += 0.1 // lands a hair off 45
+	}
+	tests := []struct {
+		aim, assist float64
+		snap        float64
+		want        bool
+	}{
+		{45, 0, 45, true},
+		{stepped, 0, 45, true},
+		{44.5, 0, 45, false},
+		{30, 0, 45, false},
+		{45, 1, 45, false}, // full aim assist fires at the solved angle instead
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets = []Target{NewTarget(g.cannon.X+150, g.cannon.Y, 1)}
+		g.aimAngle, g.aimAssist = tt.aim, tt.assist
+		if got := g.snappedTo(tt.snap); got != tt.want {
+			t.Errorf("aim %v, assist %v (launching at %v): snappedTo(%v) = %v, want %v", tt.aim, tt.assist, g.launchAngle(), tt.snap, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import "fmt"
+
+const (
+	magnusCoeff = 0.02 // m/s² of lift per rev/s of spin per m/s of speed
+	spinStep    = 2.0  // rev/s per key press
+	maxSpin     = 20.0
+)
+
+// magnusAccel returns the Magnus acceleration in m/s² on a ball moving with
+// vel (Y up) while spinning at spin rev/s. It acts at right angles to the
+// motion; positive spin is backspin, which lifts a ball flying either way.
+func magnusAccel(vel Vector2, spin float64) Vector2 {
+	if vel.X < 0 {
+		spin = -spin
+	}
+	return Vector2{-vel.Y, vel.X}.Scale(magnusCoeff * spin)
+}
+
+func spinText(spin float64) string {
+	switch {
+	case spin > 0:
+		return fmt.Sprintf("Spin: %.0f rev/s backspin", spin)
+	case spin < 0:
+		return fmt.Sprintf("Spin: %.0f rev/s topspin", -spin)
+	}
+	return "Spin: none"
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// apexHeight returns how far above its start a launch climbs, in pixels
+func apexHeight(params LaunchParams) float64 {
+	top := params.Start.Y
+	for _, p := range Simulate(params, physicsDt, int(10/physicsDt)) {
+		top = math.Min(top, p.Y)
+	}
+	return params.Start.Y - top
+}
+
+func TestSpinRaisesApex(t *testing.T) {
+	tests := []struct {
+		angle, power, spin float64
+		cmp                int // sign of the apex compared to no spin
+	}{
+		{45, 12, 10, 1},
+		{60, 15, maxSpin, 1},
+		{30, 10, 4, 1},
+		{45, 12, -10, -1}, // topspin pulls it down
+		{45, 12, 0, 0},
+	}
+	for _, tt := range tests {
+		plain := vacuumParams(tt.angle, tt.power)
+		spun := plain
+		spun.Spin = tt.spin
+		base, got := apexHeight(plain), apexHeight(spun)
+		cmp := 0
+		if got > base+1e-9 {
+			cmp = 1
+		} else if got < base-1e-9 {
+			cmp = -1
+		}
+		if cmp != tt.cmp {
+			t.Errorf("%v° at %v m/s with %v rev/s: apex %.2f px, %.2f px without spin", tt.angle, tt.power, tt.spin, got, base)
+		}
+	}
+}
+
+func TestMagnusAccel(t *testing.T) {
+	tests := []struct {
+		vel  Vector2
+		spin float64
+		want Vector2
+	}{
+		{Vector2{10, 0}, 5, Vector2{0, 1}},   // backspin lifts a level ball
+		{Vector2{-10, 0}, 5, Vector2{0, 1}},  // whichever way it flies
+		{Vector2{10, 0}, -5, Vector2{0, -1}}, // topspin drops it
+		{Vector2{0, -10}, 5, Vector2{1, 0}},
+		{Vector2{10, 0}, 0, Vector2{}},
+	}
+	for _, tt := range tests {
+		got := magnusAccel(tt.vel, tt.spin)
+		if !approxEqual(got.X, tt.want.X, 1e-9) || !approxEqual(got.Y, tt.want.Y, 1e-9) {
+			t.Errorf("magnusAccel(%v, %v) = %v, want %v", tt.vel, tt.spin, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// spreadCounts are the selectable numbers of balls per launch. They are odd,
+// so the main ball always flies at the aim angle in the middle of the spread.
+var spreadCounts = []int{1, 3, 5}
+
+// spreadAngles are the selectable total spreads in degrees
+var spreadAngles = []float64{5, 10, 20}
+
+// spreadLaunch returns the angles of n balls fanned evenly across spread
+// degrees centered on base
+func spreadLaunch(base, spread float64, n int) []float64 {
+	if n <= 1 {
+		return []float64{base}
+	}
+	angles := make([]float64, n)
+	for i := range angles {
+		angles[i] = base - spread/2 + spread*float64(i)/float64(n-1)
+	}
+	return angles
+}
+
+// nextSpreadCount cycles through the selectable balls per launch
+func nextSpreadCount(count int) int {
+	for i, c := range spreadCounts {
+		if c == count {
+			return spreadCounts[(i+1)%len(spreadCounts)]
+		}
+	}
+	return spreadCounts[0]
+}
+
+// nextSpreadAngle cycles through the selectable spreads
+func nextSpreadAngle(angle float64) float64 {
+	for i, a := range spreadAngles {
+		if a == angle {
+			return spreadAngles[(i+1)%len(spreadAngles)]
+		}
+	}
+	return spreadAngles[0]
+}
+
+// launchPellets fires the extra balls of a spread shot alongside the main
+// ball, which takes the middle angle
+func (g *Game) launchPellets() {
+	g.pellets = nil
+	angles := spreadLaunch(g.ball.Params.Angle, g.spreadAngle, g.spreadCount)
+	for i, angle := range angles {
+		if i == len(angles)/2 {
+			continue
+		}
+		params := g.ball.Params
+		params.Angle = angle
+		pellet := g.ball
+		pellet.Launch(params)
+		g.pellets = append(g.pellets, pellet)
+	}
+}
+
+// stepPellets moves the extra balls of a spread shot. Each scores on the
+// targets it hits and stops at the first thing it touches.
+func (g *Game) stepPellets(dt float64) {
+	for i := range g.pellets {
+		p := &g.pellets[i]
+		if p.Landed {
+			continue
+		}
+		prev := p.Position
+		p.Update(dt)
+
+		hit := firstHitAlong(prev, p.Position, p.Velocity, p.Radius, g.targets, g.obstacles)
+		if hit.Kind == HitTarget && g.targets[hit.Index].SpeedFits(p.Velocity.Magnitude()) {
+			g.particles.Spawn(hit.Point, 30, math.Pi/2, math.Pi, 250, 1.0, g.targets[hit.Index].Color())
+			g.hitTarget(hit.Index)
+		}
+		if hit.Kind != HitNone {
+			p.Position, p.Landed = hit.Point, true
+		} else if p.IsGrounded(g.terrain) {
+			p.Position, p.Landed = g.terrain.Crossing(prev, p.Position, p.Radius), true
+		}
+	}
+}
+
+// drawPellets draws the extra balls of a spread shot
+func (g *Game) drawPellets(screen *ebiten.Image) {
+	for _, p := range g.pellets {
+		fillCircle(screen, float32(p.Position.X), float32(p.Position.Y), float32(p.Radius), p.Color, g.antialias)
+	}
+}
+
+// spreadText describes the spread shot settings for the HUD
+func (g *Game) spreadText() string {
+	if g.spreadCount <= 1 {
+		return "Spread: off"
+	}
+	return fmt.Sprintf("Spread: %d balls over %.0f°", g.spreadCount, g.spreadAngle)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSpreadLaunchSymmetric(t *testing.T) {
+	tests := []struct {
+		base, spread float64
+		n            int
+		want         []float64
+	}{
+		{45, 10, 1, []float64{45}},
+		{45, 10, 3, []float64{40, 45, 50}},
+		{30, 20, 5, []float64{20, 25, 30, 35, 40}},
+		{60, 6, 4, []float64{57, 59, 61, 63}}, // an even count straddles the base
+		{80, 5, 0, []float64{80}},
+	}
+	for _, tt := range tests {
+		got := spreadLaunch(tt.base, tt.spread, tt.n)
+		if len(got) != len(tt.want) {
+			t.Fatalf("spreadLaunch(%v, %v, %d) = %v, want %v", tt.base, tt.spread, tt.n, got, tt.want)
+		}
+		for i := range got {
+			if !approxEqual(got[i], tt.want[i], 1e-9) {
+				t.Errorf("spreadLaunch(%v, %v, %d) = %v, want %v", tt.base, tt.spread, tt.n, got, tt.want)
+				break
+			}
+			// Each ball has a mirror image across the base angle
+			if mirror := got[len(got)-1-i]; !approxEqual(got[i]-tt.base, tt.base-mirror, 1e-9) {
+				t.Errorf("spreadLaunch(%v, %v, %d): %v and %v are not symmetric about the base", tt.base, tt.spread, tt.n, got[i], mirror)
+			}
+		}
+	}
+}
+
+func TestSpreadCycles(t *testing.T) {
+	var counts []int
+	for c, i := spreadCounts[0], 0; i < len(spreadCounts)+1; i++ {
+		counts = append(counts, c)
+		c = nextSpreadCount(c)
+	}
+	if want := append(append([]int(nil), spreadCounts...), spreadCounts[0]); !reflect.DeepEqual(counts, want) {
+		t.Errorf("cycling spread counts gave %v, want %v", counts, want)
+	}
+	if got := nextSpreadAngle(7); got != spreadAngles[0] {
+		t.Errorf("nextSpreadAngle of an unlisted spread = %v, want %v", got, spreadAngles[0])
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"image/color"
+	"math"
+)
+
+const (
+	stageDeltaV = 8.0 // m/s added along the direction of travel at separation
+	stageTime   = 1.5 // seconds into the flight at which the booster separates on its own
+)
+
+// stageVelocity returns vel sped up by deltaV along its own direction
+func stageVelocity(vel Vector2, deltaV float64) Vector2 {
+	speed := vel.Magnitude()
+	if speed == 0 {
+		return vel
+	}
+	return vel.Scale((speed + deltaV) / speed)
+}
+
+// Stage fires the second stage, once per flight. It reports whether it fired.
+func (b *Ball) Stage(deltaV float64) bool {
+	if b.Staged || !b.Launched || b.Landed {
+		return false
+	}
+	b.Staged = true
+	b.Velocity = stageVelocity(b.Velocity, deltaV)
+	return true
+}
+
+// stage separates the booster, leaving a puff of smoke behind the ball
+func (g *Game) stage() {
+	if !g.ball.Stage(stageDeltaV) {
+		return
+	}
+	back := math.Atan2(g.ball.Velocity.Y, g.ball.Velocity.X) + math.Pi
+	g.particles.Spawn(g.ball.Position, 25, back, 0.5, 100, 0.7, color.RGBA{230, 230, 230, 200})
+}
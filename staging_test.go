@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestStageAddsDeltaVOnce(t *testing.T) {
+	tests := []struct {
+		vel, want Vector2
+	}{
+		{Vector2{6, 8}, Vector2{10.8, 14.4}}, // 10 m/s becomes 18 along the same line
+		{Vector2{-3, -4}, Vector2{-7.8, -10.4}},
+		{Vector2{12, 0}, Vector2{20, 0}},
+	}
+	for _, tt := range tests {
+		b := Ball{Launched: true, Velocity: tt.vel}
+		if !b.Stage(stageDeltaV) {
+			t.Fatalf("staging at %v did not fire", tt.vel)
+		}
+		if !approxEqual(b.Velocity.X, tt.want.X, 1e-9) || !approxEqual(b.Velocity.Y, tt.want.Y, 1e-9) {
+			t.Errorf("staging at %v = %v, want %v", tt.vel, b.Velocity, tt.want)
+		}
+		if b.Stage(stageDeltaV) {
+			t.Errorf("staging at %v fired a second time", tt.vel)
+		}
+		if !approxEqual(b.Velocity.X, tt.want.X, 1e-9) || !approxEqual(b.Velocity.Y, tt.want.Y, 1e-9) {
+			t.Errorf("staging at %v again changed the velocity to %v", tt.vel, b.Velocity)
+		}
+	}
+}
+
+func TestStageNeedsFlight(t *testing.T) {
+	tests := []struct {
+		name string
+		b    Ball
+	}{
+		{"at the cannon", Ball{Velocity: Vector2{6, 8}}},
+		{"landed", Ball{Launched: true, Landed: true, Velocity: Vector2{6, 8}}},
+	}
+	for _, tt := range tests {
+		if tt.b.Stage(stageDeltaV) || tt.b.Velocity != (Vector2{6, 8}) {
+			t.Errorf("%s: staging fired, velocity %v", tt.name, tt.b.Velocity)
+		}
+	}
+	if got := stageVelocity(Vector2{}, stageDeltaV); got != (Vector2{}) {
+		t.Errorf("stageVelocity of a ball at rest = %v, want no direction to boost along", got)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// formatDuration formats a duration as mm:ss.cc, rounded to the hundredth of a second
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	cs := int64(d.Round(10*time.Millisecond) / (10 * time.Millisecond))
+	return fmt.Sprintf("%02d:%02d.%02d", cs/6000, cs%6000/100, cs%100)
+}
+
+// startStopwatch starts timing at the first launch of a game, and again at
+// the first launch after the targets were cleared
+func (g *Game) startStopwatch(now time.Time) {
+	if g.watchStart.IsZero() || !g.watchStop.IsZero() {
+		g.watchStart, g.watchStop = now, time.Time{}
+	}
+}
+
+// stopStopwatch stops the stopwatch once every target is cleared and reports the time
+func (g *Game) stopStopwatch(now time.Time) {
+	if g.watchStart.IsZero() || !g.watchStop.IsZero() {
+		return
+	}
+	g.watchStop = now
+	g.toasts.Push("Cleared in " + formatDuration(g.watchStop.Sub(g.watchStart)))
+}
+
+// stopwatchText shows the running time, or the last completion time once the targets are cleared
+func (g *Game) stopwatchText(now time.Time) string {
+	switch {
+	case g.watchStart.IsZero():
+		return "Stopwatch: starts on launch"
+	case !g.watchStop.IsZero():
+		return "Stopwatch: cleared in " + formatDuration(g.watchStop.Sub(g.watchStart))
+	}
+	return "Stopwatch: " + formatDuration(now.Sub(g.watchStart))
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "00:00.00"},
+		{1500 * time.Millisecond, "00:01.50"},
+		{59*time.Second + 994*time.Millisecond, "00:59.99"},
+		{59*time.Second + 996*time.Millisecond, "01:00.00"}, // rounds up into the next minute
+		{2*time.Minute + 3*time.Second + 40*time.Millisecond, "02:03.04"},
+		{75*time.Minute + 30*time.Second, "75:30.00"}, // minutes keep counting past the hour
+		{-time.Second, "00:00.00"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.d); got != tt.want {
+			t.Errorf("formatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestStopwatchStartsOnFirstLaunch(t *testing.T) {
+	g := newTestGame()
+	start := time.Now()
+	g.startStopwatch(start)
+	g.startStopwatch(start.Add(3 * time.Second)) // a second shot keeps the first start
+	if g.watchStart != start {
+		t.Errorf("stopwatch started at %v, want the first launch at %v", g.watchStart, start)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+// Surfaces holds the coefficient of restitution of each kind of surface the
+// ball can bounce off, so e.g. a bouncy floor can sit between dead walls
+type Surfaces struct {
+	Ground    float64 `json:"ground"`
+	Walls     float64 `json:"walls"`
+	Obstacles float64 `json:"obstacles"`
+}
+
+var defaultSurfaces = Surfaces{Ground: defaultRestitution, Walls: defaultRestitution, Obstacles: defaultRestitution}
+
+// Restitution returns the coefficient for the surface a hit landed on, 0 for anything that isn't bounced off
+func (s Surfaces) Restitution(kind HitKind) float64 {
+	switch kind {
+	case HitGround:
+		return s.Ground
+	case HitWall:
+		return s.Walls
+	case HitObstacle:
+		return s.Obstacles
+	}
+	return 0
+}
+
+// reflectOffObstacle bounces vel off the face of o that a ball coming from
+// from ran into, scaling the normal component by restitution. It reports
+// whether that was the top face.
+func reflectOffObstacle(vel, from Vector2, o Obstacle, restitution float64) (Vector2, bool) {
+	if from.X < o.Min.X || from.X > o.Max.X {
+		vel.X = -vel.X * restitution
+		return vel, false
+	}
+	vel.Y = -vel.Y * restitution
+	return vel, from.Y < o.Min.Y
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSurfaceRestitution(t *testing.T) {
+	// A bouncy floor between dead walls and middling blocks
+	s := Surfaces{Ground: 0.9, Walls: 0.2, Obstacles: 0.5}
+	block := Obstacle{Min: Vector2{500, 300}, Max: Vector2{560, 400}}
+	tests := []struct {
+		kind   HitKind
+		bounce func(e float64) (before, after float64) // speed normal to the surface
+		want   float64
+	}{
+		{HitGround, func(e float64) (float64, float64) {
+			b := Ball{Velocity: Vector2{4, -10}}
+			b.Bounce(e)
+			return 10, b.Velocity.Y
+		}, 0.9},
+		{HitWall, func(e float64) (float64, float64) {
+			_, vel, _ := reflectOffWalls(Vector2{screenWidth - 1, 300}, Vector2{12, 3}, Walls{Right: true}, e, 8)
+			return 12, -vel.X
+		}, 0.2},
+		{HitObstacle, func(e float64) (float64, float64) {
+			vel, _ := reflectOffObstacle(Vector2{8, 2}, Vector2{490, 350}, block, e) // from the left side
+			return 8, -vel.X
+		}, 0.5},
+	}
+	for _, tt := range tests {
+		e := s.Restitution(tt.kind)
+		if e != tt.want {
+			t.Errorf("Restitution(%v) = %v, want %v", tt.kind, e, tt.want)
+		}
+		before, after := tt.bounce(e)
+		if !approxEqual(after, before*tt.want, 1e-9) {
+			t.Errorf("bouncing off %v: %v m/s came back at %v m/s, want %v", tt.kind, before, after, before*tt.want)
+		}
+	}
+
+	for _, kind := range []HitKind{HitNone, HitTarget} {
+		if e := s.Restitution(kind); e != 0 {
+			t.Errorf("Restitution(%v) = %v, want 0", kind, e)
+		}
+	}
+}
+
+func TestReflectOffObstacleTop(t *testing.T) {
+	block := Obstacle{Min: Vector2{500, 300}, Max: Vector2{560, 400}}
+	vel, onTop := reflectOffObstacle(Vector2{3, -10}, Vector2{530, 290}, block, 0.5)
+	if !onTop || vel != (Vector2{3, 5}) {
+		t.Errorf("landing on the block = %v, top %v, want %v, top true", vel, onTop, Vector2{3, 5})
+	}
+	vel, onTop = reflectOffObstacle(Vector2{3, 10}, Vector2{530, 410}, block, 0.5)
+	if onTop || math.Abs(vel.Y+5) > 1e-9 {
+		t.Errorf("hitting the block from below = %v, top %v, want vy -5, top false", vel, onTop)
+	}
+}
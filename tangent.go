@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const tangentHalfLength = 24.0 // pixels the tangent line reaches either side of the ball
+
+// velocityAngleDeg returns the direction of travel of a velocity in m/s with
+// Y up, in degrees above the horizontal: positive while climbing, negative
+// while descending, and past ±90 when moving left
+func velocityAngleDeg(vel Vector2) float64 {
+	return math.Atan2(vel.Y, vel.X) * 180 / math.Pi
+}
+
+// drawTangent draws a fixed-length line through the ball along its direction
+// of travel, the tangent to its path, labelled with the path angle
+func (g *Game) drawTangent(screen *ebiten.Image, pos, vel Vector2) {
+	speed := vel.Magnitude()
+	if speed == 0 {
+		return
+	}
+	d := Vector2{vel.X, -vel.Y}.Scale(tangentHalfLength / speed)
+	a, b := pos.Add(d.Scale(-1)), pos.Add(d)
+	tangentColor := color.RGBA{255, 255, 255, 200}
+	strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 1.5, tangentColor, g.antialias)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%+.1f°", velocityAngleDeg(vel)), int(b.X)+4, int(b.Y)-8)
+}
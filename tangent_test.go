@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func TestVelocityAngleDeg(t *testing.T) {
+	tests := []struct {
+		vel  Vector2
+		want float64
+	}{
+		{Vector2{10, 0}, 0},
+		{Vector2{5, 5}, 45},   // climbing
+		{Vector2{5, -5}, -45}, // descending
+		{Vector2{3, -4}, -53.1301},
+		{Vector2{0, 7}, 90},   // straight up at the apex of a vertical shot
+		{Vector2{0, -7}, -90}, // straight down
+		{Vector2{-5, 5}, 135}, // moving left
+		{Vector2{-5, -5}, -135},
+	}
+	for _, tt := range tests {
+		if got := velocityAngleDeg(tt.vel); !approxEqual(got, tt.want, 1e-4) {
+			t.Errorf("velocityAngleDeg(%v) = %v, want %v", tt.vel, got, tt.want)
+		}
+	}
+}
+
+func TestVelocityAngleFollowsFlight(t *testing.T) {
+	params := vacuumParams(60, 15)
+	pos, vel := params.Start, params.InitialVelocity()
+	if got := velocityAngleDeg(vel); !approxEqual(got, 60, 1e-9) {
+		t.Fatalf("angle at launch = %v, want 60", got)
+	}
+	prev := 60.0
+	for i := 0; i < int(3/physicsDt); i++ {
+		pos, vel = params.Step(pos, vel, float64(i)*physicsDt, physicsDt)
+		got := velocityAngleDeg(vel)
+		if got >= prev {
+			t.Fatalf("path angle rose from %v to %v at step %d, want it to keep turning down", prev, got, i+1)
+		}
+		prev = got
+	}
+	if prev >= -60 {
+		t.Errorf("path angle %v after 3 s, want the ball well into its descent", prev)
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+)
+
+// TargetKind is a target category, deciding its point value and look
+type TargetKind int
+
+const (
+	TargetStandard TargetKind = iota
+	TargetBonus
+	TargetPenalty
+	TargetSplitter // splits into two smaller targets when destroyed
+)
+
+// Points is what destroying a target of this kind adds to the score
+func (k TargetKind) Points() int {
+	switch k {
+	case TargetBonus:
+		return 50
+	case TargetPenalty:
+		return -20
+	case TargetSplitter:
+		return 15
+	}
+	return 10
+}
+
+const (
+	targetRadius    = 15.0 // pixels, the size of a level 1 target
+	minTargetRadius = 7.0  // pixels, targets never shrink below this
+	targetShrink    = 0.9  // each level scales target radii by this
+)
+
+// levelTargetRadius returns the radius of targets on a level, shrinking with difficulty
+func levelTargetRadius(level int) float64 {
+	return math.Max(minTargetRadius, targetRadius*math.Pow(targetShrink, float64(level-1)))
+}
+
+// HitRadius is how far from the target's center the ball's edge can be and still hit it
+func (t Target) HitRadius() float64 {
+	return t.Radius + targetHitMargin
+}
+
+// Points is what destroying the target adds to the score. Scoring targets
+// smaller than the standard size are worth proportionally more, penalties
+// cost the same at any size.
+func (t Target) Points() int {
+	points := t.Kind.Points()
+	if points < 0 || t.Radius <= 0 {
+		return points
+	}
+	return int(math.Round(float64(points) * targetRadius / t.Radius))
+}
+
+// BaseColor is the color of an undamaged target of this kind
+func (k TargetKind) BaseColor() color.RGBA {
+	switch k {
+	case TargetBonus:
+		return color.RGBA{255, 200, 0, 255}
+	case TargetPenalty:
+		return color.RGBA{30, 30, 30, 255}
+	case TargetSplitter:
+		return color.RGBA{170, 60, 220, 255}
+	}
+	return color.RGBA{255, 0, 0, 255}
+}
+
+const (
+	splitScale     = 0.7  // children's radius as a fraction of the splitter's
+	splitSpeed     = 60.0 // pixels per second each child moves away from the other
+	maxSplitTarget = maxTargets + 4
+)
+
+// splitTarget returns the two standard targets a destroyed splitter breaks
+// into: smaller, one hitpoint each, and moving apart horizontally on top of
+// the splitter's own motion
+func splitTarget(t Target) []Target {
+	radius := math.Max(minTargetRadius, t.Radius*splitScale)
+	children := make([]Target, 2)
+	for i, dir := range []float64{-1, 1} {
+		c := NewTarget(t.Position.X+dir*radius, t.Position.Y, 1)
+		c.Radius = radius
+		c.Velocity = t.Velocity.Add(Vector2{dir * splitSpeed, 0})
+		children[i] = c
+	}
+	return children
+}
+
+// scoringTargetsLeft counts the targets that still need clearing. Penalty
+// targets never do, so a level ends when only they remain.
+func scoringTargetsLeft(targets []Target) int {
+	n := 0
+	for _, t := range targets {
+		if t.Kind != TargetPenalty {
+			n++
+		}
+	}
+	return n
+}
+
+// HasSpeedWindow reports whether the target only counts hits within an impact speed range
+func (t Target) HasSpeedWindow() bool {
+	return t.MaxSpeed > 0
+}
+
+// SpeedFits reports whether a ball hitting at speed m/s counts on the target
+func (t Target) SpeedFits(speed float64) bool {
+	return !t.HasSpeedWindow() || (speed >= t.MinSpeed && speed <= t.MaxSpeed)
+}
+
+// speedWindowMiss explains why a hit at speed m/s did not count
+func speedWindowMiss(t Target, speed float64) string {
+	verdict := "Too fast"
+	if speed < t.MinSpeed {
+		verdict = "Too slow"
+	}
+	return fmt.Sprintf("%s: %.1f m/s (needs %.0f-%.0f)", verdict, speed, t.MinSpeed, t.MaxSpeed)
+}
+
+// deflectOff bounces a velocity (m/s, Y up) off a round target centered at
+// center that the ball touched at point (both screen pixels), keeping
+// restitution of the speed along the contact normal
+func deflectOff(vel, point, center Vector2, restitution float64) Vector2 {
+	d := point.Add(center.Scale(-1))
+	if d.Magnitude() == 0 {
+		return vel.Scale(-restitution)
+	}
+	n := Vector2{d.X, -d.Y}.Scale(1 / d.Magnitude())
+	along := vel.X*n.X + vel.Y*n.Y
+	if along >= 0 {
+		return vel
+	}
+	return vel.Add(n.Scale(-(1 + restitution) * along))
+}
+
+// relativeToCannon returns the horizontal distance in meters from the cannon
+// to target, and the elevation of target seen from the cannon in degrees,
+// negative when it sits below the cannon
+func relativeToCannon(target, cannon Vector2, scale float64) (dist, angleDeg float64) {
+	dx := (target.X - cannon.X) / scale
+	dy := (cannon.Y - target.Y) / scale
+	return math.Abs(dx), math.Atan2(dy, math.Abs(dx)) * 180 / math.Pi
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTargetScoring(t *testing.T) {
+	tests := []struct {
+		kind   TargetKind
+		radius float64
+		want   int
+	}{
+		{TargetStandard, targetRadius, 10},
+		{TargetBonus, targetRadius, 50},
+		{TargetPenalty, targetRadius, -20},
+		{TargetSplitter, targetRadius, 15},
+		{TargetStandard, targetRadius / 2, 20}, // half the size is worth twice as much
+		{TargetBonus, targetRadius / 2, 100},
+		{TargetPenalty, targetRadius / 2, -20}, // penalties cost the same at any size
+	}
+	for _, tt := range tests {
+		target := NewTarget(600, 400, 1)
+		target.Kind, target.Radius = tt.kind, tt.radius
+		if got := target.Points(); got != tt.want {
+			t.Errorf("kind %v, radius %v: Points() = %d, want %d", tt.kind, tt.radius, got, tt.want)
+		}
+
+		g := newTestGame()
+		g.score = 100
+		g.scoreTarget(target)
+		if g.score != 100+tt.want {
+			t.Errorf("kind %v, radius %v: score after a hit = %d, want %d", tt.kind, tt.radius, g.score, 100+tt.want)
+		}
+	}
+}
+
+func TestScoringTargetsLeft(t *testing.T) {
+	targets := []Target{{Kind: TargetStandard}, {Kind: TargetPenalty}, {Kind: TargetBonus}, {Kind: TargetPenalty}}
+	if got := scoringTargetsLeft(targets); got != 2 {
+		t.Errorf("scoringTargetsLeft = %d, want 2", got)
+	}
+	if got := scoringTargetsLeft(targets[1:2]); got != 0 {
+		t.Errorf("scoringTargetsLeft with only a penalty target = %d, want 0", got)
+	}
+}
+
+func TestSpeedWindow(t *testing.T) {
+	window := Target{MinSpeed: 10, MaxSpeed: 14}
+	tests := []struct {
+		target Target
+		speed  float64
+		fits   bool
+		miss   string
+	}{
+		{window, 7.25, false, "Too slow: 7.2 m/s (needs 10-14)"},
+		{window, 10, true, ""},
+		{window, 12.5, true, ""},
+		{window, 14, true, ""},
+		{window, 18.06, false, "Too fast: 18.1 m/s (needs 10-14)"},
+		{Target{}, 3, true, ""}, // no window, any speed counts
+		{Target{}, 40, true, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.target.SpeedFits(tt.speed); got != tt.fits {
+			t.Errorf("window %v-%v: SpeedFits(%v) = %v, want %v", tt.target.MinSpeed, tt.target.MaxSpeed, tt.speed, got, tt.fits)
+		}
+		if tt.miss == "" {
+			continue
+		}
+		if got := speedWindowMiss(tt.target, tt.speed); got != tt.miss {
+			t.Errorf("speedWindowMiss at %v = %q, want %q", tt.speed, got, tt.miss)
+		}
+	}
+}
+
+func TestRelativeToCannon(t *testing.T) {
+	const scale = 50.0
+	cannon := Vector2{100, 600}
+	tests := []struct {
+		name        string
+		target      Vector2
+		dist, angle float64
+	}{
+		{"level", Vector2{600, 600}, 10, 0},
+		{"above", Vector2{600, 100}, 10, 45},
+		{"below", Vector2{600, 700}, 10, -11.3099},
+		{"steeply above", Vector2{150, 426.79}, 1, 73.9},
+		{"behind the cannon", Vector2{0, 550}, 2, 26.5651},
+		{"straight overhead", Vector2{100, 300}, 0, 90},
+	}
+	for _, tt := range tests {
+		dist, angle := relativeToCannon(tt.target, cannon, scale)
+		if !approxEqual(dist, tt.dist, 1e-9) || !approxEqual(angle, tt.angle, 0.01) {
+			t.Errorf("%s: relativeToCannon(%v) = %v m, %v°, want %v m, %v°", tt.name, tt.target, dist, angle, tt.dist, tt.angle)
+		}
+	}
+}
+
+func TestCollisionUsesTargetRadius(t *testing.T) {
+	const ballRadius = 8.0
+	tests := []struct {
+		radius, offset float64 // offset of the ball's path from the target's center
+		hit            bool
+	}{
+		{targetRadius, 29, true}, // reach is 15 + 7 margin + 8 of ball
+		{targetRadius, 31, false},
+		{minTargetRadius, 21, true},
+		{minTargetRadius, 23, false}, // a pass that would hit a standard target
+		{30, 44, true},
+		{30, 46, false},
+	}
+	for _, tt := range tests {
+		target := NewTarget(600, 300, 1)
+		target.Radius = tt.radius
+		from, to := Vector2{500, 300 - tt.offset}, Vector2{700, 300 - tt.offset}
+		hit := firstHitAlong(from, to, Vector2{10, 0}, ballRadius, []Target{target}, nil)
+		if got := hit.Kind == HitTarget; got != tt.hit {
+			t.Errorf("radius %v, passing %v px from the center: hit = %v, want %v", tt.radius, tt.offset, got, tt.hit)
+		}
+	}
+}
+
+func TestLevelTargetRadius(t *testing.T) {
+	tests := []struct {
+		level int
+		want  float64
+	}{
+		{1, targetRadius},
+		{2, targetRadius * targetShrink},
+		{4, targetRadius * targetShrink * targetShrink * targetShrink},
+		{50, minTargetRadius},
+	}
+	for _, tt := range tests {
+		if got := levelTargetRadius(tt.level); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("levelTargetRadius(%d) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestSplitTarget(t *testing.T) {
+	tests := []struct {
+		radius, wantRadius float64
+	}{
+		{20, 14},
+		{targetRadius, math.Max(minTargetRadius, targetRadius*splitScale)},
+		{8, minTargetRadius},
+	}
+	for _, tt := range tests {
+		parent := NewTarget(400, 300, 3)
+		parent.Radius = tt.radius
+		parent.Velocity = Vector2{10, -5}
+		children := splitTarget(parent)
+		if len(children) != 2 {
+			t.Fatalf("splitTarget(radius %v) made %d children, want 2", tt.radius, len(children))
+		}
+		for i, dir := range []float64{-1, 1} {
+			c := children[i]
+			if !approxEqual(c.Radius, tt.wantRadius, 1e-9) {
+				t.Errorf("splitTarget(radius %v) child %d radius = %v, want %v", tt.radius, i, c.Radius, tt.wantRadius)
+			}
+			if c.HP != 1 || c.MaxHP != 1 {
+				t.Errorf("splitTarget(radius %v) child %d HP = %d/%d, want 1/1", tt.radius, i, c.HP, c.MaxHP)
+			}
+			wantPos := Vector2{parent.Position.X + dir*tt.wantRadius, parent.Position.Y}
+			if c.Position != wantPos {
+				t.Errorf("splitTarget(radius %v) child %d position = %v, want %v", tt.radius, i, c.Position, wantPos)
+			}
+			if rel := c.Velocity.Add(parent.Velocity.Scale(-1)); !approxEqual(rel.X, dir*splitSpeed, 1e-9) || !approxEqual(rel.Y, 0, 1e-9) {
+				t.Errorf("splitTarget(radius %v) child %d velocity relative to parent = %v, want {%v 0}", tt.radius, i, rel, dir*splitSpeed)
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// defaultTerrain is the ground profile in meters above the base ground line,
+// sampled every 100 pixels from the left edge: a hill in front of the cannon
+// and a shallow valley among the targets
+var defaultTerrain = []float64{0, 0, 0.6, 1.4, 0.8, 0, 0, 0, -0.6, -0.8, -0.4, 0, 0}
+
+// terrainHeightAt linearly interpolates a height profile, sampled evenly
+// from x = 0 to x = screenWidth, at x. Beyond either end the end sample
+// holds, and an empty profile is flat at 0.
+func terrainHeightAt(x float64, profile []float64) float64 {
+	n := len(profile)
+	if n == 0 {
+		return 0
+	}
+	if n == 1 {
+		return profile[0]
+	}
+	spacing := float64(screenWidth) / float64(n-1)
+	f := math.Max(0, math.Min(float64(n-1), x/spacing))
+	i := math.Min(float64(n-2), math.Floor(f))
+	a, b := profile[int(i)], profile[int(i)+1]
+	return a + (b-a)*(f-i)
+}
+
+// Terrain is the ground profile in pixels above the base ground line
+type Terrain []float64
+
+// terrainFromMeters converts a profile in meters into a Terrain at scale pixels per meter
+func terrainFromMeters(heights []float64, scale float64) Terrain {
+	t := make(Terrain, len(heights))
+	for i, h := range heights {
+		t[i] = h * scale
+	}
+	return t
+}
+
+// SurfaceY returns the screen Y of the ground surface at x
+func (t Terrain) SurfaceY(x float64) float64 {
+	return float64(screenHeight-groundHeight) - terrainHeightAt(x, t)
+}
+
+// ContactY returns the screen Y of the center of a ball of the given radius resting on the ground at x
+func (t Terrain) ContactY(x, radius float64) float64 {
+	return t.SurfaceY(x) - radius
+}
+
+// Touches reports whether a ball of the given radius at pos, moving with vel
+// (Y up), has reached the ground: by coming down onto it, or, on a hillside,
+// by running into it with its center below the surface
+func (t Terrain) Touches(pos, vel Vector2, radius float64) bool {
+	return pos.Y >= t.ContactY(pos.X, radius) && (vel.Y < 0 || pos.Y > t.SurfaceY(pos.X))
+}
+
+// Crossing returns where the segment from prev to pos, which ends touching
+// the ground, first meets it
+func (t Terrain) Crossing(prev, pos Vector2, radius float64) Vector2 {
+	d0 := prev.Y - t.ContactY(prev.X, radius)
+	d1 := pos.Y - t.ContactY(pos.X, radius)
+	if d1 == d0 {
+		return pos
+	}
+	f := math.Max(0, math.Min(1, -d0/(d1-d0)))
+	return prev.Add(pos.Add(prev.Scale(-1)).Scale(f))
+}
+
+var whitePixel = func() *ebiten.Image {
+	img := ebiten.NewImage(3, 3)
+	img.Fill(color.White)
+	return img.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+}()
+
+// heightAboveGround returns how many meters p is above the terrain's surface directly below it
+func (g *Game) heightAboveGround(p Vector2) float64 {
+	return metersFromPixels(g.terrain.SurfaceY(p.X)-p.Y, g.scale)
+}
+
+// drawTerrain fills the ground below the terrain profile as one polygon
+func (g *Game) drawTerrain(screen *ebiten.Image) {
+	var path vector.Path
+	path.MoveTo(0, screenHeight)
+	for x := 0.0; x <= screenWidth; x += 10 {
+		path.LineTo(float32(x), float32(g.terrain.SurfaceY(x)))
+	}
+	path.LineTo(screenWidth, screenHeight)
+	path.Close()
+
+	vs, is := path.AppendVerticesAndIndicesForFilling(nil, nil)
+	for i := range vs {
+		vs[i].SrcX, vs[i].SrcY = 1, 1
+		vs[i].ColorR, vs[i].ColorG, vs[i].ColorB, vs[i].ColorA = 34.0/255, 139.0/255, 34.0/255, 1
+	}
+	screen.DrawTriangles(vs, is, whitePixel, &ebiten.DrawTrianglesOptions{AntiAlias: g.antialias})
+}
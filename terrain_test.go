@@ -0,0 +1,62 @@
+package main
+
+import "testing"
+
+func TestTerrainHeightAt(t *testing.T) {
+	profile := []float64{0, 10, 30, 20, 0}
+	const s = screenWidth / 4.0 // pixels between samples
+	tests := []struct {
+		x       float64
+		profile []float64
+		want    float64
+	}{
+		{0, profile, 0},
+		{s, profile, 10},          // on a sample
+		{s / 2, profile, 5},       // halfway up the first slope
+		{1.25 * s, profile, 15},   // a quarter of the way from 10 to 30
+		{2.5 * s, profile, 25},    // halfway down from 30 to 20
+		{3.9 * s, profile, 2},     // nearly at the far end
+		{screenWidth, profile, 0}, // the last sample
+		{-50, profile, 0},         // left of the screen holds the first sample
+		{screenWidth + 80, []float64{0, 4}, 4},
+		{300, nil, 0},          // no profile is flat
+		{300, []float64{7}, 7}, // a single sample is flat at its height
+	}
+	for _, tt := range tests {
+		if got := terrainHeightAt(tt.x, tt.profile); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("terrainHeightAt(%v, %v) = %v, want %v", tt.x, tt.profile, got, tt.want)
+		}
+	}
+}
+
+func TestTerrainContact(t *testing.T) {
+	groundY := float64(screenHeight - groundHeight)
+	terrain := terrainFromMeters([]float64{0, 2, 0}, defaultScale)
+	x := screenWidth / 2.0 // the peak
+	if got, want := terrain.SurfaceY(x), groundY-2*defaultScale; !approxEqual(got, want, 1e-9) {
+		t.Errorf("SurfaceY at the peak = %v, want %v", got, want)
+	}
+	if got, want := terrain.ContactY(x, 8), groundY-2*defaultScale-8; !approxEqual(got, want, 1e-9) {
+		t.Errorf("ContactY at the peak = %v, want %v", got, want)
+	}
+}
+
+func TestHeightAboveGround(t *testing.T) {
+	g := newTestGame()
+	g.terrain = terrainFromMeters([]float64{0, 4, 0}, g.scale) // a 4 m hill peaking mid-screen
+	tests := []struct {
+		x, meters float64 // ball x, and its height above the flat ground line
+		want      float64
+	}{
+		{0, 3, 3},
+		{screenWidth / 2, 3, -1}, // inside the hill
+		{screenWidth / 2, 10, 6},
+		{screenWidth / 4, 5, 3},
+	}
+	for _, tt := range tests {
+		p := Vector2{tt.x, float64(screenHeight-groundHeight) - tt.meters*g.scale}
+		if got := g.heightAboveGround(p); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("heightAboveGround at x %v, %v m up = %v, want %v", tt.x, tt.meters, got, tt.want)
+		}
+	}
+}
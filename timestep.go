@@ -0,0 +1,42 @@
+package main
+
+import "time"
+
+const (
+	physicsDt    = 1.0 / 240.0 // seconds per physics substep
+	maxFrameTime = 0.25        // longest real frame fed to the physics, so a stall cannot snowball
+)
+
+// FixedStep turns uneven frame times into whole physics substeps. Time that
+// does not fill a substep carries over to the next frame.
+type FixedStep struct {
+	Accumulator float64
+	last        time.Time
+}
+
+// Tick returns the real seconds since the previous tick, 0 on the first one
+func (f *FixedStep) Tick(now time.Time) float64 {
+	elapsed := 0.0
+	if !f.last.IsZero() {
+		elapsed = now.Sub(f.last).Seconds()
+	}
+	f.last = now
+	return elapsed
+}
+
+// Advance adds elapsed seconds of game time and returns how many substeps to run
+func (f *FixedStep) Advance(elapsed float64) int {
+	if elapsed > maxFrameTime {
+		elapsed = maxFrameTime
+	}
+	f.Accumulator += elapsed
+	steps := int(f.Accumulator / physicsDt)
+	f.Accumulator -= float64(steps) * physicsDt
+	return steps
+}
+
+// Alpha is how far the leftover time reaches into the next substep, for
+// interpolating the drawn state between the last two physics states
+func (f *FixedStep) Alpha() float64 {
+	return f.Accumulator / physicsDt
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFixedStepTracksWallTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		frames []float64 // real seconds per frame, repeated
+	}{
+		{"steady 60 Hz", []float64{1.0 / 60.0}},
+		{"steady 144 Hz", []float64{1.0 / 144.0}},
+		{"30 Hz", []float64{1.0 / 30.0}},
+		{"jittery", []float64{0.011, 0.023, 0.002, 0.017, 0.031}},
+		{"tiny frames", []float64{0.0005}},
+	}
+	for _, tt := range tests {
+		var f FixedStep
+		wall, steps := 0.0, 0
+		for i := 0; i < 2000; i++ {
+			dt := tt.frames[i%len(tt.frames)]
+			wall += dt
+			steps += f.Advance(dt)
+			if sim := float64(steps) * physicsDt; wall-sim < -1e-9 || wall-sim >= physicsDt+1e-9 {
+				t.Fatalf("%s, frame %d: %.6f s simulated for %.6f s of wall time", tt.name, i, sim, wall)
+			}
+		}
+		if a := f.Alpha(); a < 0 || a >= 1+1e-9 {
+			t.Errorf("%s: Alpha() = %v, want it in [0, 1)", tt.name, a)
+		}
+	}
+}
+
+func TestFixedStepCapsLongFrames(t *testing.T) {
+	var f FixedStep
+	if n, want := f.Advance(5), int(maxFrameTime/physicsDt); n != want {
+		t.Errorf("Advance(5) = %d substeps, want the capped %d", n, want)
+	}
+}
+
+func TestFixedStepTick(t *testing.T) {
+	var f FixedStep
+	start := time.Unix(1000, 0)
+	ticks := []struct {
+		at   time.Duration
+		want float64
+	}{
+		{0, 0}, // the first tick has nothing to measure from
+		{16 * time.Millisecond, 0.016},
+		{50 * time.Millisecond, 0.034},
+	}
+	for _, tt := range ticks {
+		if got := f.Tick(start.Add(tt.at)); !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("Tick at %v = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
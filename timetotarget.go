@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// timeToX returns how long a body starting at x0 and moving horizontally at
+// vx takes to reach targetX. ok is false when it never gets there: the
+// target lies behind the direction of travel, or the body has no horizontal
+// speed and is not already there.
+func timeToX(x0, vx, targetX float64) (float64, bool) {
+	dx := targetX - x0
+	if vx == 0 {
+		return 0, dx == 0
+	}
+	t := dx / vx
+	return t, t >= 0
+}
+
+// pathTimeToX returns when a path sampled every dt seconds first reaches x,
+// and its height there, interpolating within the segment that gets there.
+// ok is false when the path ends first.
+func pathTimeToX(points []Vector2, dt, x float64) (t, y float64, ok bool) {
+	for i := 1; i < len(points); i++ {
+		p0, p1 := points[i-1], points[i]
+		s, reaches := timeToX(p0.X, (p1.X-p0.X)/dt, x)
+		if reaches && s <= dt {
+			return float64(i-1)*dt + s, p0.Y + (p1.Y-p0.Y)*s/dt, true
+		}
+	}
+	return 0, 0, false
+}
+
+// timeToTargetText predicts, along the integrated flight of the current aim
+// with drag, wind and terrain, when the ball reaches the selected target's X
+// and how far above or below the target's center it passes then
+func (g *Game) timeToTargetText() string {
+	if len(g.targets) == 0 {
+		return "Time to target: no target"
+	}
+	target := g.targets[g.activeTarget]
+
+	// Targets are left out so the path runs on past the one it would hit
+	points, _ := traceTrajectory(g.launchParams(), nil, g.obstacles, g.walls, g.terrain, previewDt, 10.0)
+	t, y, ok := pathTimeToX(points, previewDt, target.Position.X)
+	if !ok {
+		return "Time to target: never reaches its X"
+	}
+
+	dy := metersFromPixels(target.Position.Y-y, g.scale)
+	side := "above"
+	if dy < 0 {
+		side = "below"
+	}
+	return fmt.Sprintf("Time to target: %.2f s, %.2f m %s it", t, math.Abs(dy), side)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTimeToX(t *testing.T) {
+	tests := []struct {
+		x0, vx, targetX float64
+		want            float64
+		wantOK          bool
+	}{
+		{100, 50, 600, 10, true},   // forward
+		{600, -50, 100, 10, true},  // forward, flying left
+		{100, 50, 100, 0, true},    // already there
+		{100, 50, 50, -1, false},   // behind
+		{600, -50, 700, -2, false}, // behind, flying left
+		{100, 0, 100, 0, true},     // standing on it
+		{100, 0, 300, 0, false},    // never moves
+	}
+	for _, tt := range tests {
+		got, ok := timeToX(tt.x0, tt.vx, tt.targetX)
+		if ok != tt.wantOK || !approxEqual(got, tt.want, 1e-9) {
+			t.Errorf("timeToX(%v, %v, %v) = %v, %v, want %v, %v", tt.x0, tt.vx, tt.targetX, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestPathTimeToX(t *testing.T) {
+	params := vacuumParams(45, 12)
+	points, _ := traceTrajectory(params, nil, nil, Walls{}, nil, previewDt, 10)
+	vx := params.InitialVelocity().X
+	tests := []struct {
+		dx     float64 // pixels ahead of the cannon
+		wantOK bool
+	}{
+		{0, true},
+		{100, true},
+		{333, true},
+		{-50, false},  // behind the cannon
+		{5000, false}, // past where the ball lands
+	}
+	for _, tt := range tests {
+		x := params.Start.X + tt.dx
+		got, y, ok := pathTimeToX(points, previewDt, x)
+		if ok != tt.wantOK {
+			t.Errorf("pathTimeToX(%v px ahead) reaches = %v, want %v", tt.dx, ok, tt.wantOK)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		want := metersFromPixels(tt.dx, params.Scale) / vx
+		if !approxEqual(got, want, 1e-6) {
+			t.Errorf("pathTimeToX(%v px ahead) = %v s, want %v", tt.dx, got, want)
+		}
+		wantY := params.Start.Y - (params.InitialVelocity().Y*want-0.5*params.Gravity*want*want)*params.Scale
+		if math.Abs(y-wantY) > 0.5 {
+			t.Errorf("pathTimeToX(%v px ahead) height = %v, want %v", tt.dx, y, wantY)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const (
+	toastLifetime = 2.0 // seconds a toast stays up
+	toastFadeTime = 0.5 // seconds of fading at the end of its life
+)
+
+// ShotOutcome classifies how a finished shot went
+type ShotOutcome int
+
+const (
+	OutcomeHit ShotOutcome = iota
+	OutcomeNearMiss
+	OutcomeMiss
+	OutcomeOutOfRange
+)
+
+// classifyShot decides the outcome from whether a target was hit, whether
+// the ball came within the near-miss distance of any remaining target and
+// where the ball stopped
+func classifyShot(hit, near bool, landing Vector2) ShotOutcome {
+	switch {
+	case hit:
+		return OutcomeHit
+	case landing.X < 0 || landing.X > screenWidth:
+		return OutcomeOutOfRange
+	case near:
+		return OutcomeNearMiss
+	}
+	return OutcomeMiss
+}
+
+func (o ShotOutcome) Toast() string {
+	switch o {
+	case OutcomeHit:
+		return "Bullseye!"
+	case OutcomeNearMiss:
+		return "Near miss!"
+	case OutcomeOutOfRange:
+		return "Out of range"
+	}
+	return "Missed"
+}
+
+// Toast is a short message shown at the top of the screen
+type Toast struct {
+	Text string
+	Age  float64
+	Life float64
+}
+
+// ToastQueue holds the toasts currently on screen, oldest first
+type ToastQueue struct {
+	Toasts []Toast
+}
+
+func (q *ToastQueue) Push(text string) {
+	q.Toasts = append(q.Toasts, Toast{Text: text, Life: toastLifetime})
+}
+
+// Update ages every toast by dt and drops the expired ones
+func (q *ToastQueue) Update(dt float64) {
+	alive := q.Toasts[:0]
+	for _, t := range q.Toasts {
+		t.Age += dt
+		if t.Age < t.Life {
+			alive = append(alive, t)
+		}
+	}
+	q.Toasts = alive
+}
+
+// alpha fades a toast out over its last toastFadeTime seconds
+func (t Toast) alpha() float64 {
+	return math.Max(0, math.Min(1, (t.Life-t.Age)/toastFadeTime))
+}
+
+func (q *ToastQueue) Draw(screen *ebiten.Image, antialias bool) {
+	centerX := screen.Bounds().Dx() / 2
+	for i, t := range q.Toasts {
+		w := len(t.Text)*6 + 20
+		x, y := centerX-w/2, 30+i*24
+		fillRect(screen, float32(x), float32(y), float32(w), 20, color.RGBA{0, 0, 0, uint8(180 * t.alpha())}, antialias)
+		// The debug font has no alpha, so the text disappears with the box's last moments
+		if t.alpha() > 0.3 {
+			ebitenutil.DebugPrintAt(screen, t.Text, x+10, y+2)
+		}
+	}
+}
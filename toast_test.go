@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestShotOutcomeToast(t *testing.T) {
+	tests := []struct {
+		hit, near bool
+		landing   Vector2
+		want      string
+	}{
+		{true, false, Vector2{600, 690}, "Bullseye!"},
+		{true, true, Vector2{1300, 690}, "Bullseye!"},
+		{false, true, Vector2{600, 690}, "Near miss!"},
+		{false, false, Vector2{600, 690}, "Missed"},
+		{false, false, Vector2{1300, 690}, "Out of range"},
+		{false, true, Vector2{-20, 690}, "Out of range"},
+	}
+	for _, tt := range tests {
+		var q ToastQueue
+		q.Push(classifyShot(tt.hit, tt.near, tt.landing).Toast())
+		if len(q.Toasts) != 1 || q.Toasts[0].Text != tt.want {
+			t.Errorf("hit %v, near %v, landing %v: toasts %+v, want %q", tt.hit, tt.near, tt.landing, q.Toasts, tt.want)
+			continue
+		}
+
+		q.Update(toastLifetime - 0.1)
+		if len(q.Toasts) != 1 {
+			t.Errorf("%q expired before its lifetime", tt.want)
+		}
+		q.Update(0.2)
+		if len(q.Toasts) != 0 {
+			t.Errorf("%q still up after its lifetime", tt.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	maxTracePoints = 6000 // oldest dots are dropped past this
+	traceStride    = 8    // physics steps between stamped dots, about 30 per second
+)
+
+// stampTrace adds p to the traced points, dropping the oldest ones once
+// there are more than limit
+func stampTrace(points []Vector2, p Vector2, limit int) []Vector2 {
+	points = append(points, p)
+	if over := len(points) - limit; over > 0 {
+		points = append(points[:0], points[over:]...)
+	}
+	return points
+}
+
+// drawTrace draws every stamped dot faintly, so paths many shots share build up into a density map
+func (g *Game) drawTrace(screen *ebiten.Image) {
+	dotColor := color.RGBA{255, 255, 255, 40}
+	for _, p := range g.trace {
+		fillCircle(screen, float32(p.X), float32(p.Y), 1.5, dotColor, g.antialias)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestStampTraceCapped(t *testing.T) {
+	tests := []struct {
+		stamps, limit int
+		want          int
+		first         float64 // X of the oldest dot kept
+	}{
+		{3, 10, 3, 0},
+		{10, 10, 10, 0},
+		{11, 10, 10, 1},
+		{250, 100, 100, 150},
+		{5, 1, 1, 4},
+	}
+	for _, tt := range tests {
+		var points []Vector2
+		for i := 0; i < tt.stamps; i++ {
+			points = stampTrace(points, Vector2{float64(i), 0}, tt.limit)
+			if len(points) > tt.limit {
+				t.Fatalf("%d stamps, limit %d: %d dots after stamp %d", tt.stamps, tt.limit, len(points), i+1)
+			}
+		}
+		if len(points) != tt.want || points[0].X != tt.first || points[len(points)-1].X != float64(tt.stamps-1) {
+			t.Errorf("%d stamps, limit %d: %d dots from %v to %v, want %d from %v to %v",
+				tt.stamps, tt.limit, len(points), points[0].X, points[len(points)-1].X, tt.want, tt.first, tt.stamps-1)
+		}
+	}
+}
+
+func TestTraceAccumulatesAcrossShots(t *testing.T) {
+	g := newTestGame()
+	g.targets, g.obstacles = nil, nil
+	g.traceMode = true
+	counts := []int{}
+	for _, angle := range []float64{30, 50} {
+		g.ResetBall()
+		g.aimAngle, g.aimPower = angle, 12
+		g.launch()
+		for i := 0; !g.ball.Landed && i < 20/physicsDt; i++ {
+			g.step(physicsDt)
+		}
+		counts = append(counts, len(g.trace))
+	}
+	if counts[0] == 0 || counts[1] <= counts[0] {
+		t.Errorf("trace held %v dots after each shot, want it to keep growing", counts)
+	}
+
+	g.applyAction(ActionClearTrace)
+	if len(g.trace) != 0 {
+		t.Errorf("%d dots left after clearing the trace", len(g.trace))
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+)
+
+const trailLifetime = 3.0 // seconds of flight a trail segment takes to fade out
+
+// TrailPoint is a sampled ball position, the flight time it was recorded at
+// and the ball's speed in m/s at that moment. A point's age is the ball's
+// flight time since T, which stands still while the game is paused.
+type TrailPoint struct {
+	Pos   Vector2
+	T     float64
+	Speed float64
+}
+
+// trailAlpha returns the opacity of a trail segment age seconds old, fading
+// linearly from opaque when new to transparent once lifetime has passed
+func trailAlpha(age, lifetime float64) uint8 {
+	if lifetime <= 0 {
+		return 0
+	}
+	k := 1 - age/lifetime
+	return uint8(255 * math.Max(0, math.Min(1, k)))
+}
+
+// sampleTrail adds a trail point at every multiple of TrailInterval of
+// flight time since the last one, interpolating between the state at prevT
+// and the current one. Samples are spaced evenly in flight time whatever the
+// frame dt, time scale or pauses in between.
+func (b *Ball) sampleTrail(prevPos Vector2, prevSpeed, prevT float64) {
+	speed := b.Velocity.Magnitude()
+	if len(b.Trail) == 0 || b.TrailInterval <= 0 {
+		b.Trail = append(b.Trail, TrailPoint{b.Position, b.Time, speed})
+		return
+	}
+	for {
+		t := b.Trail[len(b.Trail)-1].T + b.TrailInterval
+		if t > b.Time+1e-9 {
+			return
+		}
+		f := 1.0
+		if b.Time > prevT {
+			f = math.Max(0, math.Min(1, (t-prevT)/(b.Time-prevT)))
+		}
+		pos := prevPos.Add(b.Position.Add(prevPos.Scale(-1)).Scale(f))
+		b.Trail = append(b.Trail, TrailPoint{pos, t, prevSpeed + (speed-prevSpeed)*f})
+	}
+}
+
+// TrailTrimMode selects how old trail points are discarded
+type TrailTrimMode int
+
+const (
+	TrimByCount  TrailTrimMode = iota // keep at most MaxTrailLen points
+	TrimByTime                        // keep points younger than MaxTrailAge seconds
+	TrimByLength                      // keep the newest MaxTrailDist pixels of path
+	trailTrimModeCount
+)
+
+func (m TrailTrimMode) String() string {
+	switch m {
+	case TrimByCount:
+		return "count"
+	case TrimByTime:
+		return "time"
+	case TrimByLength:
+		return "length"
+	}
+	return "unknown"
+}
+
+// Next cycles to the following trim mode
+func (m TrailTrimMode) Next() TrailTrimMode {
+	return (m + 1) % trailTrimModeCount
+}
+
+// trimTrail drops the oldest trail points according to the active trim mode
+func (b *Ball) trimTrail() {
+	switch b.TrimMode {
+	case TrimByCount:
+		if len(b.Trail) > b.MaxTrailLen {
+			b.Trail = b.Trail[len(b.Trail)-b.MaxTrailLen:]
+		}
+	case TrimByTime:
+		start := 0
+		for start < len(b.Trail)-1 && b.Time-b.Trail[start].T > b.MaxTrailAge {
+			start++
+		}
+		b.Trail = b.Trail[start:]
+	case TrimByLength:
+		length := 0.0
+		for i := len(b.Trail) - 1; i > 0; i-- {
+			d := b.Trail[i].Pos.Add(b.Trail[i-1].Pos.Scale(-1))
+			length += d.Magnitude()
+			if length > b.MaxTrailDist {
+				b.Trail = b.Trail[i:]
+				break
+			}
+		}
+	}
+}
+
+// TrimDescription describes the active trim mode and its limit for the HUD
+func (b *Ball) TrimDescription() string {
+	switch b.TrimMode {
+	case TrimByTime:
+		return fmt.Sprintf("%s (%.1f s)", b.TrimMode, b.MaxTrailAge)
+	case TrimByLength:
+		return fmt.Sprintf("%s (%.0f px)", b.TrimMode, b.MaxTrailDist)
+	}
+	return fmt.Sprintf("%s (%d pts)", b.TrimMode, b.MaxTrailLen)
+}
+
+// speedColor maps speed onto a gradient from blue at minS to red at maxS
+func speedColor(speed, minS, maxS float64) color.RGBA {
+	k := 0.0
+	if maxS > minS {
+		k = math.Max(0, math.Min(1, (speed-minS)/(maxS-minS)))
+	}
+	return color.RGBA{uint8(255 * k), 0, uint8(255 * (1 - k)), 255}
+}
+
+// speedRange returns the slowest and fastest speeds along the trail
+func speedRange(trail []TrailPoint) (minS, maxS float64) {
+	minS, maxS = math.Inf(1), math.Inf(-1)
+	for _, p := range trail {
+		minS = math.Min(minS, p.Speed)
+		maxS = math.Max(maxS, p.Speed)
+	}
+	return minS, maxS
+}
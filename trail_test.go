@@ -0,0 +1,199 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+// straightTrail is n points one pixel and one sample interval apart
+func straightTrail(n int, interval float64) []TrailPoint {
+	trail := make([]TrailPoint, n)
+	for i := range trail {
+		trail[i] = TrailPoint{Pos: Vector2{float64(i), 0}, T: float64(i) * interval}
+	}
+	return trail
+}
+
+func TestTrailTrimModes(t *testing.T) {
+	tests := []struct {
+		mode TrailTrimMode
+		next TrailTrimMode
+		want int // points left of 100
+	}{
+		{TrimByCount, TrimByTime, 10},
+		{TrimByTime, TrimByLength, 21},
+		{TrimByLength, TrimByCount, 31},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.Next(); got != tt.next {
+			t.Errorf("%v.Next() = %v, want %v", tt.mode, got, tt.next)
+		}
+		b := Ball{
+			Trail:        straightTrail(100, 0.1),
+			Time:         9.9,
+			TrimMode:     tt.mode,
+			MaxTrailLen:  10,
+			MaxTrailAge:  2.0,
+			MaxTrailDist: 30,
+		}
+		b.trimTrail()
+		if len(b.Trail) != tt.want {
+			t.Errorf("trimming by %v left %d points, want %d", tt.mode, len(b.Trail), tt.want)
+		}
+		if last := b.Trail[len(b.Trail)-1].Pos.X; last != 99 {
+			t.Errorf("trimming by %v dropped the newest point, last is at %v", tt.mode, last)
+		}
+	}
+}
+
+func TestTrailSampleCadence(t *testing.T) {
+	for _, interval := range []float64{1.0 / 60.0, 1.0 / 30.0, 0.1} {
+		b := Ball{MaxTrailLen: 10000, TrailInterval: interval}
+		b.Launch(vacuumParams(45, 12))
+		for i := 0; i < 240; i++ {
+			b.Update(physicsDt)
+		}
+		if want := int(1/interval+1e-9) + 1; len(b.Trail) != want {
+			t.Errorf("interval %.3f s: %d points after 1 s, want %d", interval, len(b.Trail), want)
+		}
+		for i := 1; i < len(b.Trail); i++ {
+			if dt := b.Trail[i].T - b.Trail[i-1].T; !approxEqual(dt, interval, 1e-9) {
+				t.Errorf("interval %.3f s: points %d and %d are %.4f s apart", interval, i-1, i, dt)
+				break
+			}
+		}
+	}
+}
+
+func TestSpeedColor(t *testing.T) {
+	tests := []struct {
+		speed, minS, maxS float64
+		want              color.RGBA
+	}{
+		{5, 5, 25, color.RGBA{0, 0, 255, 255}},    // slowest is blue
+		{25, 5, 25, color.RGBA{255, 0, 0, 255}},   // fastest is red
+		{15, 5, 25, color.RGBA{127, 0, 127, 255}}, // halfway between
+		{0, 5, 25, color.RGBA{0, 0, 255, 255}},    // below the range clamps
+		{40, 5, 25, color.RGBA{255, 0, 0, 255}},   // above the range clamps
+		{10, 10, 10, color.RGBA{0, 0, 255, 255}},  // an empty range stays blue
+	}
+	for _, tt := range tests {
+		if got := speedColor(tt.speed, tt.minS, tt.maxS); got != tt.want {
+			t.Errorf("speedColor(%v, %v, %v) = %v, want %v", tt.speed, tt.minS, tt.maxS, got, tt.want)
+		}
+	}
+}
+
+func TestSpeedRange(t *testing.T) {
+	trail := []TrailPoint{{Speed: 12}, {Speed: 8.5}, {Speed: 9}, {Speed: 13.2}}
+	if minS, maxS := speedRange(trail); minS != 8.5 || maxS != 13.2 {
+		t.Errorf("speedRange = %v, %v, want 8.5, 13.2", minS, maxS)
+	}
+}
+
+func TestLastTrailKeptAfterLanding(t *testing.T) {
+	tests := []struct {
+		angle, power float64
+	}{
+		{30, 10},
+		{45, 12},
+		{70, 15},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles = nil, nil
+		g.aimAngle, g.aimPower = tt.angle, tt.power
+		g.launch()
+		for i := 0; !g.ball.Landed; i++ {
+			if i > 20/physicsDt {
+				t.Fatalf("%v° at %v m/s never landed", tt.angle, tt.power)
+			}
+			if g.lastTrail != nil {
+				t.Fatalf("%v° at %v m/s: lastTrail set mid-flight", tt.angle, tt.power)
+			}
+			g.step(physicsDt)
+		}
+		if len(g.lastTrail) < 2 {
+			t.Errorf("%v° at %v m/s: lastTrail has %d points after landing", tt.angle, tt.power, len(g.lastTrail))
+		}
+
+		// Sending the ball back keeps the trail on show, the next launch clears it
+		g.ResetBall()
+		if len(g.lastTrail) < 2 {
+			t.Errorf("%v° at %v m/s: ResetBall cleared lastTrail", tt.angle, tt.power)
+		}
+		g.launch()
+		if g.lastTrail != nil {
+			t.Errorf("%v° at %v m/s: the next launch kept %d points of lastTrail", tt.angle, tt.power, len(g.lastTrail))
+		}
+	}
+}
+
+func TestTrailAlpha(t *testing.T) {
+	tests := []struct {
+		age, lifetime float64
+		want          uint8
+	}{
+		{0, 2, 255}, // brand new is opaque
+		{2, 2, 0},   // gone at the end of its lifetime
+		{5, 2, 0},   // and stays gone
+		{1, 2, 127}, // halfway
+		{0.5, 2, 191},
+		{-1, 2, 255}, // not yet aged
+		{1, 0, 0},    // no lifetime, nothing shows
+	}
+	for _, tt := range tests {
+		if got := trailAlpha(tt.age, tt.lifetime); got != tt.want {
+			t.Errorf("trailAlpha(%v, %v) = %d, want %d", tt.age, tt.lifetime, got, tt.want)
+		}
+	}
+
+	prev := trailAlpha(0, trailLifetime)
+	for age := 0.1; age <= trailLifetime; age += 0.1 {
+		if a := trailAlpha(age, trailLifetime); a > prev {
+			t.Errorf("trailAlpha rose from %d to %d at %.1f s", prev, a, age)
+		}
+		prev = trailAlpha(age, trailLifetime)
+	}
+}
+
+func TestTrailEvenAcrossPause(t *testing.T) {
+	tests := []struct {
+		timeScale float64
+		resume    float64 // seconds of real time the first frame after the pause reports
+	}{
+		{1, 1.0 / 60.0},
+		{1, 0.25},
+		{0.25, 1.0 / 60.0},
+		{2, 0.1},
+	}
+	for _, tt := range tests {
+		g := newTestGame()
+		g.targets, g.obstacles, g.terrain = nil, nil, nil
+		g.timeScale = tt.timeScale
+		g.launch()
+		for i := 0; i < 20; i++ {
+			g.advance(1.0/60.0, nil, InputIntent{})
+		}
+		g.paused = true
+		for i := 0; i < 30; i++ {
+			g.advance(1.0/60.0, nil, InputIntent{})
+		}
+		g.paused = false
+		g.advance(tt.resume, nil, InputIntent{})
+		for i := 0; i < 20 && !g.ball.Landed; i++ {
+			g.advance(1.0/60.0, nil, InputIntent{})
+		}
+
+		trail := g.ball.Trail
+		if len(trail) < 3 {
+			t.Fatalf("scale %v, resume %v s: only %d trail points", tt.timeScale, tt.resume, len(trail))
+		}
+		for i := 1; i < len(trail); i++ {
+			if dt := trail[i].T - trail[i-1].T; !approxEqual(dt, g.ball.TrailInterval, 1e-9) {
+				t.Errorf("scale %v, resume %v s: points %d and %d are %.4f s apart, want %.4f", tt.timeScale, tt.resume, i-1, i, dt, g.ball.TrailInterval)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "math"
+
+const reloadTime = 0.3 // seconds for the ball to glide back to the cannon
+
+// easeOutCubic maps t in [0, 1] to a curve that starts fast and settles gently
+func easeOutCubic(t float64) float64 {
+	t = math.Max(0, math.Min(1, t))
+	return 1 - math.Pow(1-t, 3)
+}
+
+// ReturnTo starts gliding the ball from where it is back to home
+func (b *Ball) ReturnTo(home Vector2) {
+	b.Returning = true
+	b.ReturnFrom = b.Position
+	b.ReturnHome = home
+	b.ReturnTime = 0
+}
+
+// updateReturn advances the glide back to the cannon by dt seconds
+func (b *Ball) updateReturn(dt float64) {
+	b.ReturnTime += dt
+	k := easeOutCubic(b.ReturnTime / reloadTime)
+	b.Position = b.ReturnFrom.Add(b.ReturnHome.Add(b.ReturnFrom.Scale(-1)).Scale(k))
+	if b.ReturnTime >= reloadTime {
+		b.Position = b.ReturnHome
+		b.Returning = false
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestEaseOutCubic(t *testing.T) {
+	tests := []struct {
+		t, want float64
+	}{
+		{0, 0},
+		{1, 1},
+		{0.5, 0.875},
+		{-1, 0}, // clamped
+		{2, 1},
+	}
+	for _, tt := range tests {
+		if got := easeOutCubic(tt.t); !approxEqual(got, tt.want, 1e-12) {
+			t.Errorf("easeOutCubic(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+
+	prev := easeOutCubic(0)
+	for i := 1; i <= 100; i++ {
+		x := float64(i) / 100
+		if got := easeOutCubic(x); got <= prev {
+			t.Fatalf("easeOutCubic(%v) = %v, not above easeOutCubic(%v) = %v", x, got, x-0.01, prev)
+		} else {
+			prev = got
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Button is a clickable rectangle in screen pixels
+type Button struct {
+	X, Y, Width, Height int
+	Label               string
+}
+
+// Contains reports whether the screen point (x, y) lies inside the button
+func (b Button) Contains(x, y int) bool {
+	return x >= b.X && x < b.X+b.Width && y >= b.Y && y < b.Y+b.Height
+}
+
+func (b Button) Draw(screen *ebiten.Image, hovered, antialias bool) {
+	fill := color.RGBA{50, 50, 50, 220}
+	if hovered {
+		fill = color.RGBA{90, 90, 140, 240}
+	}
+	fillRect(screen, float32(b.X), float32(b.Y), float32(b.Width), float32(b.Height), fill, antialias)
+	strokeRect(screen, float32(b.X), float32(b.Y), float32(b.Width), float32(b.Height), 2, color.RGBA{255, 255, 255, 255}, antialias)
+
+	// The debug font is 6x16 pixels per character
+	textX := b.X + (b.Width-len(b.Label)*6)/2
+	textY := b.Y + (b.Height-16)/2
+	ebitenutil.DebugPrintAt(screen, b.Label, textX, textY)
+}
+
+const (
+	pauseResume = iota
+	pauseRestart
+	pausePlayers
+	pauseExport
+	pauseQuit
+)
+
+// pauseMenuButtons lays out the pause menu buttons centered on screen. The
+// player count button offers whichever mode is not active.
+func pauseMenuButtons(twoPlayer bool) []Button {
+	players := "Two players"
+	if twoPlayer {
+		players = "One player"
+	}
+	labels := []string{"Resume", "Restart", players, "Export summary", "Quit"}
+	width, height, gap := 200, 40, 15
+	top := screenHeight/2 - (len(labels)*(height+gap)-gap)/2
+
+	buttons := make([]Button, len(labels))
+	for i, label := range labels {
+		buttons[i] = Button{
+			X:      (screenWidth - width) / 2,
+			Y:      top + i*(height+gap),
+			Width:  width,
+			Height: height,
+			Label:  label,
+		}
+	}
+	return buttons
+}
+
+// updatePauseMenu handles clicks on the pause menu. It returns ebiten.Termination
+// when Quit is chosen, after exporting the session summary.
+func (g *Game) updatePauseMenu() error {
+	if !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return nil
+	}
+
+	x, y := ebiten.CursorPosition()
+	for i, b := range pauseMenuButtons(g.twoPlayer) {
+		if !b.Contains(x, y) {
+			continue
+		}
+		switch i {
+		case pauseResume:
+			g.paused = false
+		case pauseRestart:
+			g.reset()
+		case pausePlayers:
+			g.toggleTwoPlayer()
+		case pauseExport:
+			g.exportSession()
+		case pauseQuit:
+			g.saveHighScore()
+			g.exportSession()
+			return ebiten.Termination
+		}
+		break
+	}
+	return nil
+}
+
+func (g *Game) drawPauseMenu(screen *ebiten.Image) {
+	fillRect(screen, 0, 0, screenWidth, screenHeight, color.RGBA{0, 0, 0, 120}, g.antialias)
+
+	buttons := pauseMenuButtons(g.twoPlayer)
+	ebitenutil.DebugPrintAt(screen, "PAUSED", screenWidth/2-18, buttons[0].Y-30)
+
+	x, y := ebiten.CursorPosition()
+	for _, b := range buttons {
+		b.Draw(screen, b.Contains(x, y), g.antialias)
+	}
+}
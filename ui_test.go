@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestButtonContains(t *testing.T) {
+	b := Button{X: 100, Y: 50, Width: 80, Height: 20, Label: "Resume"}
+	tests := []struct {
+		x, y int
+		want bool
+	}{
+		{100, 50, true},  // top-left corner
+		{140, 60, true},  // middle
+		{179, 69, true},  // last pixel inside
+		{180, 60, false}, // right edge is exclusive
+		{140, 70, false}, // bottom edge is exclusive
+		{99, 60, false},
+		{140, 49, false},
+	}
+	for _, tt := range tests {
+		if got := b.Contains(tt.x, tt.y); got != tt.want {
+			t.Errorf("Contains(%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
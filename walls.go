@@ -0,0 +1,54 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Walls selects which world boundaries the ball bounces off
+type Walls struct {
+	Left, Top, Right bool
+}
+
+// reflectOffWalls bounces a ball of the given radius off any enabled wall it
+// has crossed while moving towards it. The velocity component normal to the
+// wall is reversed and scaled by restitution. It reports whether a wall was hit.
+func reflectOffWalls(pos, vel Vector2, walls Walls, restitution, radius float64) (Vector2, Vector2, bool) {
+	hit := false
+	if walls.Left && pos.X < radius && vel.X < 0 {
+		pos.X = radius
+		vel.X = -vel.X * restitution
+		hit = true
+	}
+	if walls.Right && pos.X > screenWidth-radius && vel.X > 0 {
+		pos.X = screenWidth - radius
+		vel.X = -vel.X * restitution
+		hit = true
+	}
+	// Velocity is Y-up while positions are screen pixels, so rising means vel.Y > 0
+	if walls.Top && pos.Y < radius && vel.Y > 0 {
+		pos.Y = radius
+		vel.Y = -vel.Y * restitution
+		hit = true
+	}
+	return pos, vel, hit
+}
+
+// wallHit reports whether a ball of the given radius at p reaches an enabled wall, for stopping the preview
+func wallHit(p Vector2, walls Walls, radius float64) bool {
+	return (walls.Left && p.X < radius) || (walls.Right && p.X > screenWidth-radius) || (walls.Top && p.Y < radius)
+}
+
+func (g *Game) drawWalls(screen *ebiten.Image) {
+	wallColor := color.RGBA{90, 90, 90, 255}
+	if g.walls.Left {
+		fillRect(screen, 0, 0, 4, float32(g.terrain.SurfaceY(0)), wallColor, g.antialias)
+	}
+	if g.walls.Right {
+		fillRect(screen, screenWidth-4, 0, 4, float32(g.terrain.SurfaceY(screenWidth)), wallColor, g.antialias)
+	}
+	if g.walls.Top {
+		fillRect(screen, 0, 0, screenWidth, 4, wallColor, g.antialias)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestReflectOffWalls(t *testing.T) {
+	const r, e = 8.0, 0.5
+	all := Walls{Left: true, Top: true, Right: true}
+	tests := []struct {
+		name     string
+		pos, vel Vector2
+		walls    Walls
+		wantPos  Vector2
+		wantVel  Vector2
+		wantHit  bool
+	}{
+		{"left", Vector2{3, 300}, Vector2{-10, 2}, all, Vector2{r, 300}, Vector2{5, 2}, true},
+		{"right", Vector2{screenWidth - 2, 300}, Vector2{10, 2}, all, Vector2{screenWidth - r, 300}, Vector2{-5, 2}, true},
+		{"top", Vector2{600, 1}, Vector2{4, 6}, all, Vector2{600, r}, Vector2{4, -3}, true},
+		{"corner", Vector2{2, 2}, Vector2{-4, 8}, all, Vector2{r, r}, Vector2{2, -4}, true},
+		{"left wall off", Vector2{3, 300}, Vector2{-10, 2}, Walls{Top: true, Right: true}, Vector2{3, 300}, Vector2{-10, 2}, false},
+		{"moving away from the wall", Vector2{3, 300}, Vector2{10, 2}, all, Vector2{3, 300}, Vector2{10, 2}, false},
+		{"falling away from the ceiling", Vector2{600, 1}, Vector2{4, -6}, all, Vector2{600, 1}, Vector2{4, -6}, false},
+		{"open air", Vector2{600, 300}, Vector2{-10, 10}, all, Vector2{600, 300}, Vector2{-10, 10}, false},
+	}
+	for _, tt := range tests {
+		pos, vel, hit := reflectOffWalls(tt.pos, tt.vel, tt.walls, e, r)
+		if pos != tt.wantPos || vel != tt.wantVel || hit != tt.wantHit {
+			t.Errorf("%s: reflectOffWalls = %v, %v, %v, want %v, %v, %v", tt.name, pos, vel, hit, tt.wantPos, tt.wantVel, tt.wantHit)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	windStep        = 0.5 // m/s² per key press
+	maxWind         = 5.0
+	gustComponents  = 3
+	defaultGustSize = 1.5 // peak m/s² added by each gust component
+)
+
+// gust is one sinusoidal component of the gusting wind
+type gust struct {
+	amp, freq, phase float64
+}
+
+// Wind is a horizontal acceleration in m/s² (positive blows right). With
+// gusts on it varies over time as a sum of sinusoids drawn from Seed, so the
+// same seed always produces the same gusts.
+type Wind struct {
+	Base  float64
+	Gusts bool
+	Seed  int64
+	gusts []gust
+}
+
+func NewWind(seed int64) Wind {
+	w := Wind{Seed: seed}
+	rng := rand.New(rand.NewSource(seed))
+	for i := 0; i < gustComponents; i++ {
+		w.gusts = append(w.gusts, gust{
+			amp:   defaultGustSize * (0.5 + 0.5*rng.Float64()),
+			freq:  0.2 + 1.3*rng.Float64(),
+			phase: 2 * math.Pi * rng.Float64(),
+		})
+	}
+	return w
+}
+
+// WindAt returns the wind acceleration t seconds into the game
+func (w Wind) WindAt(t float64) float64 {
+	wind := w.Base
+	if w.Gusts {
+		for _, g := range w.gusts {
+			wind += g.amp * math.Sin(g.freq*t+g.phase)
+		}
+	}
+	return wind
+}
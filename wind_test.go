@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestWindAtReproducible(t *testing.T) {
+	tests := []struct {
+		seed  int64
+		base  float64
+		gusts bool
+	}{
+		{defaultSeed, 0, true},
+		{42, 1.5, true},
+		{42, -2, false},
+	}
+	times := []float64{0, 0.5, 3, 17.25, 120}
+	for _, tt := range tests {
+		a, b := NewWind(tt.seed), NewWind(tt.seed)
+		a.Base, a.Gusts = tt.base, tt.gusts
+		b.Base, b.Gusts = tt.base, tt.gusts
+		varies := false
+		for _, tm := range times {
+			wa, wb := a.WindAt(tm), b.WindAt(tm)
+			if wa != wb {
+				t.Errorf("seed %d: WindAt(%v) = %v and %v for the same seed", tt.seed, tm, wa, wb)
+			}
+			varies = varies || wa != tt.base
+		}
+		if varies != tt.gusts {
+			t.Errorf("seed %d, gusts %v: wind varies over time = %v", tt.seed, tt.gusts, varies)
+		}
+	}
+
+	// A different seed gusts differently
+	a, b := NewWind(1), NewWind(2)
+	a.Gusts, b.Gusts = true, true
+	if a.WindAt(10) == b.WindAt(10) {
+		t.Errorf("seeds 1 and 2 give the same wind at 10 s")
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	windsockX        = screenWidth - 250 // screen pixels of the top of the pole
+	windsockY        = 25
+	windsockPole     = 60
+	windsockMinLen   = 20.0 // pixels of a limp sock
+	windsockMaxLen   = 55.0 // pixels of a sock stretched out by the strongest wind
+	windsockSegments = 8
+)
+
+// windsockTip returns where the sock's tip sits relative to the top of the
+// pole. Stronger wind stretches it out towards the horizontal, calm air lets
+// it hang down, and the wind's sign picks the side it points to.
+func windsockTip(wind float64) Vector2 {
+	stretch := math.Min(1, math.Abs(wind)/maxWind)
+	length := windsockMinLen + (windsockMaxLen-windsockMinLen)*stretch
+	droop := (1 - stretch) * 0.45 * math.Pi
+	dir := 1.0
+	if wind < 0 {
+		dir = -1
+	}
+	return Vector2{dir * length * math.Cos(droop), length * math.Sin(droop)}
+}
+
+// updateWindsock advances the ripple, faster in stronger wind
+func (g *Game) updateWindsock(dt float64) {
+	stretch := math.Min(1, math.Abs(g.wind.WindAt(g.time))/maxWind)
+	g.windsockPhase = math.Mod(g.windsockPhase+dt*(3+9*stretch), 2*math.Pi)
+}
+
+// drawWindsock draws the pole and a striped sock that leans and ripples with the current wind
+func (g *Game) drawWindsock(screen *ebiten.Image) {
+	wind := g.wind.WindAt(g.time)
+	top := Vector2{windsockX, windsockY}
+	strokeLine(screen, windsockX, windsockY, windsockX, windsockY+windsockPole, 2, color.RGBA{200, 200, 200, 255}, g.antialias)
+
+	tip := windsockTip(wind)
+	length := tip.Magnitude()
+	normal := Vector2{-tip.Y / length, tip.X / length}
+	ripple := 2 + 3*math.Min(1, math.Abs(wind)/maxWind)
+	point := func(f float64) Vector2 {
+		wave := math.Sin(g.windsockPhase-f*2*math.Pi) * ripple * f
+		return top.Add(tip.Scale(f)).Add(normal.Scale(wave))
+	}
+
+	for i := 0; i < windsockSegments; i++ {
+		f0, f1 := float64(i)/windsockSegments, float64(i+1)/windsockSegments
+		a, b := point(f0), point(f1)
+		stripe := color.RGBA{255, 80, 0, 255}
+		if i%2 == 1 {
+			stripe = color.RGBA{255, 255, 255, 255}
+		}
+		strokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), float32(10-5*f0), stripe, g.antialias)
+	}
+}
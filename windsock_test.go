@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestWindsockTip(t *testing.T) {
+	tests := []struct {
+		wind float64
+		want Vector2
+	}{
+		{0, Vector2{3.1287, 19.7538}}, // calm: short and hanging nearly straight down
+		{maxWind / 2, Vector2{28.5152, 24.3543}},
+		{-maxWind / 2, Vector2{-28.5152, 24.3543}}, // mirrored for wind blowing left
+		{maxWind, Vector2{windsockMaxLen, 0}},      // full strength: straight out
+		{-maxWind, Vector2{-windsockMaxLen, 0}},
+		{3 * maxWind, Vector2{windsockMaxLen, 0}}, // stronger gusts don't stretch it further
+	}
+	for _, tt := range tests {
+		got := windsockTip(tt.wind)
+		if !approxEqual(got.X, tt.want.X, 1e-4) || !approxEqual(got.Y, tt.want.Y, 1e-4) {
+			t.Errorf("windsockTip(%v) = %v, want %v", tt.wind, got, tt.want)
+		}
+	}
+}
+
+func TestWindsockStretchesWithWind(t *testing.T) {
+	prev := 0.0
+	for _, wind := range []float64{0, 1, 2, 3, 4, 5} {
+		length := windsockTip(wind).Magnitude()
+		if length <= prev {
+			t.Errorf("wind %v: sock %.2f px long, not longer than %.2f px in weaker wind", wind, length, prev)
+		}
+		prev = length
+	}
+}